@@ -1,3 +1,5 @@
 module github.com/wojnosystems/validates
 
+go 1.23
+
 require golang.org/x/text v0.3.0
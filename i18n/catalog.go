@@ -0,0 +1,149 @@
+package i18n
+
+import (
+	"sort"
+
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// The i18n package provides a message catalog holding every default
+// message in issers, keyed by the stable message IDs returned by
+// issers.DefaultMessages (which are the same as the error codes).
+// Team-specific translations can be layered on top of it.
+//
+// @example
+// ```go
+// b := i18n.NewBuilder()
+// err := i18n.Overlay(b, language.German, map[string]string{
+//   issers.CodeRequired: "muss angegeben werden",
+// })
+// p := message.NewPrinter(language.German, message.Catalog(b))
+// ```
+
+// DefaultLanguage is the language of the default messages. It is the fallback
+// language of catalogs created by NewBuilder
+var DefaultLanguage = language.English
+
+// pluralMessages are the English messages that change depending on the number
+// they refer to. Messages that are not listed use the format from issers.DefaultMessages
+var pluralMessages = map[string]catalog.Message{
+	issers.CodeStringLengthBetween: characters(2,
+		"length should be between %d and %d character",
+		"length should be between %d and %d characters"),
+	issers.CodeStringLengthGreaterThan: characters(1,
+		"length should be greater than %d character",
+		"length should be greater than %d characters"),
+	issers.CodeStringLengthLessThan: characters(1,
+		"length should be less than %d character",
+		"length should be less than %d characters"),
+	issers.CodeStringLengthGreaterThanOrEqual: characters(1,
+		"length should be greater than or equal to %d character",
+		"length should be greater than or equal to %d characters"),
+	issers.CodeStringLengthLessThanOrEqual: characters(1,
+		"length should be less than or equal to %d character",
+		"length should be less than or equal to %d characters"),
+}
+
+// translations are the bundled translations of the default messages, by language
+var translations = map[language.Tag]map[string]catalog.Message{
+	language.German:     german,
+	language.French:     french,
+	language.Spanish:    spanish,
+	language.Portuguese: portuguese,
+	language.Italian:    italian,
+	language.Japanese:   japanese,
+	language.Polish:     polish,
+}
+
+// Languages returns DefaultLanguage followed by every language with bundled translations
+func Languages() []language.Tag {
+	tags := []language.Tag{DefaultLanguage}
+	for tag := range translations {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags[1:], func(a, b int) bool {
+		return tags[a+1].String() < tags[b+1].String()
+	})
+	return tags
+}
+
+// labelTemplates are the templates that combine a translated label with a translated
+// message, for the languages that can't use issers.DefaultLabeledMsgFmt
+var labelTemplates = map[language.Tag]string{
+	language.Japanese: "%sは%s",
+}
+
+// timeFormats are the layouts, as per time.Time.Format, used to write the times in the
+// messages, for the languages that don't use issers.DefaultTimeFormat
+var timeFormats = map[language.Tag]string{
+	language.AmericanEnglish: "01/02/2006 3:04:05 PM MST",
+	language.German:          "02.01.2006 15:04:05 MST",
+	language.French:          "02/01/2006 15:04:05 MST",
+	language.Spanish:         "02/01/2006 15:04:05 MST",
+	language.Portuguese:      "02/01/2006 15:04:05 MST",
+	language.Italian:         "02/01/2006 15:04:05 MST",
+	language.Japanese:        "2006/01/02 15:04:05 MST",
+	language.Polish:          "02.01.2006 15:04:05 MST",
+}
+
+// characters selects between the singular and plural form of a message
+// based on the count in the arg-th (1-based) argument
+func characters(arg int, one, other string) catalog.Message {
+	return plural.Selectf(arg, "%d", "one", one, "other", other)
+}
+
+// NewBuilder creates a catalog builder that holds every default message in
+// DefaultLanguage and in every language returned by Languages. Use it with
+// message.Catalog to create a message.Printer for the language of your user
+// @param opts are passed on to catalog.NewBuilder. The fallback language is
+//   DefaultLanguage unless a catalog.Fallback option is provided
+// @return the builder, which can be extended with Overlay or Builder.Set
+func NewBuilder(opts ...catalog.Option) *catalog.Builder {
+	b := catalog.NewBuilder(append([]catalog.Option{catalog.Fallback(DefaultLanguage)}, opts...)...)
+	for id, msgFmt := range issers.DefaultMessages() {
+		if msg, ok := pluralMessages[id]; ok {
+			mustSet(b.Set(DefaultLanguage, id, msg))
+		} else {
+			mustSet(b.SetString(DefaultLanguage, id, msgFmt))
+		}
+	}
+	mustSet(b.SetString(DefaultLanguage, issers.LabeledMsgID, issers.DefaultLabeledMsgFmt))
+	for tag, messages := range translations {
+		for id, msg := range messages {
+			mustSet(b.Set(tag, id, msg))
+		}
+	}
+	for tag, template := range labelTemplates {
+		mustSet(b.SetString(tag, issers.LabeledMsgID, template))
+	}
+	mustSet(b.SetString(DefaultLanguage, issers.TimeFormatMsgID, issers.DefaultTimeFormat))
+	for tag, layout := range timeFormats {
+		mustSet(b.SetString(tag, issers.TimeFormatMsgID, layout))
+	}
+	return b
+}
+
+// Overlay layers translations on top of the messages already in the builder,
+// replacing any message with the same ID for that language. Use this to add a
+// language or to change the wording of the default messages
+// @param messages are message formats keyed by message ID, e.g.: issers.CodeRequired
+// @return err if a message could not be compiled, the remaining messages are still set
+func Overlay(b *catalog.Builder, tag language.Tag, messages map[string]string) (err error) {
+	for id, msgFmt := range messages {
+		if setErr := b.SetString(tag, id, msgFmt); setErr != nil && err == nil {
+			err = setErr
+		}
+	}
+	return err
+}
+
+// mustSet panics if a built-in message could not be added to the catalog.
+// This can only happen if a built-in message is malformed
+func mustSet(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/issers"
+	"github.com/wojnosystems/validates/tree"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestNewBuilder(t *testing.T) {
+	p := message.NewPrinter(language.AmericanEnglish, message.Catalog(NewBuilder()))
+	cases := map[string]struct {
+		err      ifaces.ValidateError
+		expected string
+	}{
+		"simple": {
+			err:      issers.ShouldBePresentErr,
+			expected: "should be present",
+		},
+		"formatted": {
+			err:      issers.NewShouldBeIntBetween(1, 32),
+			expected: "should be between 1 and 32",
+		},
+		"plural one": {
+			err:      issers.NewShouldBeStringLengthGreaterThanOrEqual(1),
+			expected: "length should be greater than or equal to 1 character",
+		},
+		"plural other": {
+			err:      issers.NewShouldBeStringLengthBetween(1, 32),
+			expected: "length should be between 1 and 32 characters",
+		},
+		"customized": {
+			err: func() *issers.ShouldBeMsg {
+				e := issers.NewShouldBeIntGreaterThanOrEqual(1)
+				e.MsgFmt = "requires more than %d"
+				return e
+			}(),
+			expected: "requires more than 1",
+		},
+	}
+
+	for caseName, c := range cases {
+		if actual := c.err.ErrorI18n(p); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, caseName, c.expected, actual)
+		}
+	}
+}
+
+func TestNewBuilder_HasEveryDefaultMessage(t *testing.T) {
+	b := NewBuilder()
+	p := message.NewPrinter(DefaultLanguage, message.Catalog(b))
+	for id := range issers.DefaultMessages() {
+		// Without a catalog entry, the ID itself would be printed
+		if actual := p.Sprintf(id); actual == id {
+			t.Errorf(`expected a message for "%s"`, id)
+		}
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	b := NewBuilder()
+	err := Overlay(b, language.English, map[string]string{
+		issers.CodeRequired: "is required",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := message.NewPrinter(language.English, message.Catalog(b))
+	if actual := issers.ShouldBePresentErr.ErrorI18n(p); actual != "is required" {
+		t.Errorf(`expected "is required" but got "%s"`, actual)
+	}
+	if actual := issers.ShouldBeTrueErr.ErrorI18n(p); actual != "should be true" {
+		t.Errorf(`expected "should be true" but got "%s"`, actual)
+	}
+}
+
+func TestTranslations_HaveEveryDefaultMessage(t *testing.T) {
+	defaults := issers.DefaultMessages()
+	for tag, messages := range translations {
+		for id := range defaults {
+			if _, ok := messages[id]; !ok {
+				t.Errorf(`%s: missing a translation for "%s"`, tag, id)
+			}
+		}
+		for id := range messages {
+			if _, ok := defaults[id]; !ok {
+				t.Errorf(`%s: "%s" is not a default message`, tag, id)
+			}
+		}
+	}
+}
+
+func TestTranslations(t *testing.T) {
+	b := NewBuilder()
+	cases := []struct {
+		tag      language.Tag
+		err      ifaces.ValidateError
+		expected string
+	}{
+		{
+			tag:      language.German,
+			err:      issers.NewShouldBeIntBetween(1, 32),
+			expected: "muss zwischen 1 und 32 liegen",
+		},
+		{
+			tag:      language.German,
+			err:      issers.NewShouldBeBetween(time.Second, 90*time.Second),
+			expected: "muss zwischen 1s und 1m30s liegen",
+		},
+		{
+			tag:      language.MustParse("de-AT"),
+			err:      issers.ShouldBePresentErr,
+			expected: "muss angegeben werden",
+		},
+		{
+			tag:      language.BrazilianPortuguese,
+			err:      issers.ShouldBePresentErr,
+			expected: "deve estar presente",
+		},
+		{
+			tag:      language.French,
+			err:      issers.NewShouldBeStringLengthGreaterThanOrEqual(1),
+			expected: "doit contenir au moins 1 caractère",
+		},
+		{
+			tag:      language.Polish,
+			err:      issers.NewShouldBeStringLengthGreaterThanOrEqual(3),
+			expected: "musi mieć co najmniej 3 znaki",
+		},
+		{
+			tag:      language.Polish,
+			err:      issers.NewShouldBeStringLengthGreaterThanOrEqual(5),
+			expected: "musi mieć co najmniej 5 znaków",
+		},
+		{
+			tag:      language.Japanese,
+			err:      issers.NewShouldBeEmail(),
+			expected: "有効なメールアドレスである必要があります",
+		},
+		{
+			tag:      language.German,
+			err:      issers.NewShouldBePresentIfCondition(tree.NewPath().DownField("land"), "in der EU liegt"),
+			expected: "muss angegeben werden, wenn land in der EU liegt",
+		},
+		{
+			tag:      language.German,
+			err:      issers.NewSimpleValidateError("should be present"),
+			expected: "should be present",
+		},
+		{
+			tag:      language.Dutch,
+			err:      issers.ShouldBePresentErr,
+			expected: "should be present",
+		},
+	}
+
+	for _, c := range cases {
+		p := message.NewPrinter(c.tag, message.Catalog(b))
+		if actual := c.err.ErrorI18n(p); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, c.tag, c.expected, actual)
+		}
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	tags := Languages()
+	if tags[0] != DefaultLanguage {
+		t.Errorf("expected %s first but got %s", DefaultLanguage, tags[0])
+	}
+	if len(tags) != len(translations)+1 {
+		t.Errorf("expected %d languages but got %d", len(translations)+1, len(tags))
+	}
+}
+
+func TestNewBuilder_LabeledMessages(t *testing.T) {
+	b := NewBuilder()
+	cases := []struct {
+		tag      language.Tag
+		label    string
+		expected string
+	}{
+		{tag: language.English, label: "Name", expected: "Name should be present"},
+		{tag: language.German, label: "Name", expected: "Name muss angegeben werden"},
+		{tag: language.Japanese, label: "名前", expected: "名前は必須です"},
+		{tag: language.English, label: "email", expected: "email should be present"},
+		{tag: language.English, label: "required", expected: "required should be present"},
+		{tag: language.German, label: "url", expected: "url muss angegeben werden"},
+	}
+	for _, c := range cases {
+		p := message.NewPrinter(c.tag, message.Catalog(b))
+		if actual := issers.ShouldBePresentErr.ErrorI18nLabeled(p, c.label); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, c.tag, c.expected, actual)
+		}
+	}
+}
+
+func TestNewBuilder_TranslatedLabelsAndConditions(t *testing.T) {
+	b := NewBuilder()
+	err := Overlay(b, language.German, map[string]string{
+		issers.LabelMsgID("email"):            "E-Mail-Adresse",
+		issers.ConditionMsgID("is in the EU"): "in der EU liegt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		tag      language.Tag
+		err      ifaces.LabeledValidateError
+		label    string
+		expected string
+	}{
+		{
+			tag:      language.German,
+			err:      issers.ShouldBePresentErr,
+			label:    "email",
+			expected: "E-Mail-Adresse muss angegeben werden",
+		},
+		{
+			tag:      language.German,
+			err:      issers.NewShouldBePresentIfCondition(tree.NewPath().DownField("land"), "is in the EU"),
+			label:    "email",
+			expected: "E-Mail-Adresse muss angegeben werden, wenn land in der EU liegt",
+		},
+		{
+			tag:      language.English,
+			err:      issers.NewShouldBePresentIfCondition(tree.NewPath().DownField("min"), "between"),
+			label:    "email",
+			expected: "email should be present when min between",
+		},
+	}
+	for _, c := range cases {
+		p := message.NewPrinter(c.tag, message.Catalog(b))
+		if actual := c.err.ErrorI18nLabeled(p, c.label); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, c.tag, c.expected, actual)
+		}
+	}
+}
+
+func TestNewBuilder_TimeFormats(t *testing.T) {
+	b := NewBuilder()
+	err := issers.NewShouldBeTimeBefore(time.Date(2020, 3, 14, 15, 9, 26, 0, time.UTC))
+	cases := []struct {
+		tag      language.Tag
+		expected string
+	}{
+		{tag: language.English, expected: "should be before 2020-03-14 15:09:26 UTC"},
+		{tag: language.AmericanEnglish, expected: "should be before 03/14/2020 3:09:26 PM UTC"},
+		{tag: language.German, expected: "muss vor 14.03.2020 15:09:26 UTC liegen"},
+		{tag: language.Japanese, expected: "2020/03/14 15:09:26 UTCより前である必要があります"},
+	}
+	for _, c := range cases {
+		p := message.NewPrinter(c.tag, message.Catalog(b))
+		if actual := err.ErrorI18n(p); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, c.tag, c.expected, actual)
+		}
+	}
+}
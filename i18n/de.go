@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// german are the German translations of the default messages
+var german = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("muss wahr sein"),
+	issers.CodeFalse:    catalog.String("muss falsch sein"),
+	issers.CodeRequired: catalog.String("muss angegeben werden"),
+
+	issers.CodeRequiredIf:      catalog.String("muss für den aktuellen Wert von %s angegeben werden"),
+	issers.CodeRequiredUnless:  catalog.String("muss angegeben werden, es sei denn, %s erlaubt das Weglassen"),
+	issers.CodeRequiredWith:    catalog.String("muss angegeben werden, wenn %s angegeben ist"),
+	issers.CodeRequiredWithout: catalog.String("muss angegeben werden, wenn %s nicht angegeben ist"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("muss angegeben werden, wenn %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("muss angegeben werden, es sei denn, %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("genau eines von %s muss angegeben werden"),
+	issers.CodeAtLeastOneOf:      catalog.String("mindestens eines von %s muss angegeben werden"),
+	issers.CodeMutuallyExclusive: catalog.String("höchstens eines von %s darf angegeben werden"),
+	issers.CodeConflict:          catalog.String("darf nicht zusammen mit %s angegeben werden"),
+
+	issers.CodeIntBetween:            catalog.String("muss zwischen %d und %d liegen"),
+	issers.CodeIntGreaterThan:        catalog.String("muss größer als %d sein"),
+	issers.CodeIntLessThan:           catalog.String("muss kleiner als %d sein"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("muss größer oder gleich %d sein"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("muss kleiner oder gleich %d sein"),
+
+	issers.CodeFloat64Between:            catalog.String("muss zwischen %f und %f liegen"),
+	issers.CodeFloat64GreaterThan:        catalog.String("muss größer als %f sein"),
+	issers.CodeFloat64LessThan:           catalog.String("muss kleiner als %f sein"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("muss größer oder gleich %f sein"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("muss kleiner oder gleich %f sein"),
+
+	issers.CodeBetween:            catalog.String("muss zwischen %v und %v liegen"),
+	issers.CodeGreaterThan:        catalog.String("muss größer als %v sein"),
+	issers.CodeLessThan:           catalog.String("muss kleiner als %v sein"),
+	issers.CodeGreaterThanOrEqual: catalog.String("muss größer oder gleich %v sein"),
+	issers.CodeLessThanOrEqual:    catalog.String("muss kleiner oder gleich %v sein"),
+
+	issers.CodeEqualToField:     catalog.String("muss gleich %s sein"),
+	issers.CodeConfirmation:     catalog.String("muss mit %s übereinstimmen"),
+	issers.CodeAfterField:       catalog.String("muss nach %s liegen"),
+	issers.CodeBeforeField:      catalog.String("muss vor %s liegen"),
+	issers.CodeGreaterThanField: catalog.String("muss größer als %s sein"),
+	issers.CodeLessThanField:    catalog.String("muss kleiner als %s sein"),
+
+	issers.CodeTimeBefore:  catalog.String("muss vor %s liegen"),
+	issers.CodeTimeAfter:   catalog.String("muss nach %s liegen"),
+	issers.CodeTimeBetween: catalog.String("muss zwischen %s und %s liegen"),
+	issers.CodeTimeWithin:  catalog.String("darf höchstens %v von jetzt abweichen"),
+	issers.CodeTimeLayout:  catalog.String("muss eine Zeitangabe im Format %s sein"),
+
+	issers.CodeStringLengthBetween:            catalog.String("muss zwischen %d und %d Zeichen lang sein"),
+	issers.CodeStringLengthGreaterThan:        catalog.String("muss länger als %d Zeichen sein"),
+	issers.CodeStringLengthLessThan:           catalog.String("muss kürzer als %d Zeichen sein"),
+	issers.CodeStringLengthGreaterThanOrEqual: catalog.String("muss mindestens %d Zeichen lang sein"),
+	issers.CodeStringLengthLessThanOrEqual:    catalog.String("darf höchstens %d Zeichen lang sein"),
+
+	issers.CodeMatchingRegexp: catalog.String("hat ein ungültiges Format"),
+	issers.CodeEmail:          catalog.String("muss eine gültige E-Mail-Adresse sein"),
+	issers.CodeInStringSlice:  catalog.String("ist kein zulässiger Wert"),
+	issers.CodeNotEmpty:       catalog.String("darf nicht leer sein"),
+	issers.CodeURL:            catalog.String("muss eine URL sein, ist es aber nicht, weil %s"),
+}
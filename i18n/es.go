@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// spanish are the Spanish translations of the default messages
+var spanish = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("debe ser verdadero"),
+	issers.CodeFalse:    catalog.String("debe ser falso"),
+	issers.CodeRequired: catalog.String("debe estar presente"),
+
+	issers.CodeRequiredIf:      catalog.String("debe estar presente para el valor actual de %s"),
+	issers.CodeRequiredUnless:  catalog.String("debe estar presente salvo que %s permita omitirlo"),
+	issers.CodeRequiredWith:    catalog.String("debe estar presente cuando %s está presente"),
+	issers.CodeRequiredWithout: catalog.String("debe estar presente cuando %s no está presente"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("debe estar presente cuando %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("debe estar presente salvo que %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("exactamente uno de %s debe estar presente"),
+	issers.CodeAtLeastOneOf:      catalog.String("al menos uno de %s debe estar presente"),
+	issers.CodeMutuallyExclusive: catalog.String("como máximo uno de %s puede estar presente"),
+	issers.CodeConflict:          catalog.String("no debe estar presente junto con %s"),
+
+	issers.CodeIntBetween:            catalog.String("debe estar entre %d y %d"),
+	issers.CodeIntGreaterThan:        catalog.String("debe ser mayor que %d"),
+	issers.CodeIntLessThan:           catalog.String("debe ser menor que %d"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("debe ser mayor o igual que %d"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("debe ser menor o igual que %d"),
+
+	issers.CodeFloat64Between:            catalog.String("debe estar entre %f y %f"),
+	issers.CodeFloat64GreaterThan:        catalog.String("debe ser mayor que %f"),
+	issers.CodeFloat64LessThan:           catalog.String("debe ser menor que %f"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("debe ser mayor o igual que %f"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("debe ser menor o igual que %f"),
+
+	issers.CodeBetween:            catalog.String("debe estar entre %v y %v"),
+	issers.CodeGreaterThan:        catalog.String("debe ser mayor que %v"),
+	issers.CodeLessThan:           catalog.String("debe ser menor que %v"),
+	issers.CodeGreaterThanOrEqual: catalog.String("debe ser mayor o igual que %v"),
+	issers.CodeLessThanOrEqual:    catalog.String("debe ser menor o igual que %v"),
+
+	issers.CodeEqualToField:     catalog.String("debe ser igual a %s"),
+	issers.CodeConfirmation:     catalog.String("debe coincidir con %s"),
+	issers.CodeAfterField:       catalog.String("debe ser posterior a %s"),
+	issers.CodeBeforeField:      catalog.String("debe ser anterior a %s"),
+	issers.CodeGreaterThanField: catalog.String("debe ser mayor que %s"),
+	issers.CodeLessThanField:    catalog.String("debe ser menor que %s"),
+
+	issers.CodeTimeBefore:  catalog.String("debe ser anterior a %s"),
+	issers.CodeTimeAfter:   catalog.String("debe ser posterior a %s"),
+	issers.CodeTimeBetween: catalog.String("debe estar entre %s y %s"),
+	issers.CodeTimeWithin:  catalog.String("debe estar a menos de %v de ahora"),
+	issers.CodeTimeLayout:  catalog.String("debe ser una fecha con el formato %s"),
+
+	issers.CodeStringLengthBetween: characters(2,
+		"debe tener entre %d y %d carácter",
+		"debe tener entre %d y %d caracteres"),
+	issers.CodeStringLengthGreaterThan: characters(1,
+		"debe tener más de %d carácter",
+		"debe tener más de %d caracteres"),
+	issers.CodeStringLengthLessThan: characters(1,
+		"debe tener menos de %d carácter",
+		"debe tener menos de %d caracteres"),
+	issers.CodeStringLengthGreaterThanOrEqual: characters(1,
+		"debe tener al menos %d carácter",
+		"debe tener al menos %d caracteres"),
+	issers.CodeStringLengthLessThanOrEqual: characters(1,
+		"debe tener como máximo %d carácter",
+		"debe tener como máximo %d caracteres"),
+
+	issers.CodeMatchingRegexp: catalog.String("no tiene el formato correcto"),
+	issers.CodeEmail:          catalog.String("debe ser una dirección de correo electrónico válida"),
+	issers.CodeInStringSlice:  catalog.String("no es un valor aceptable"),
+	issers.CodeNotEmpty:       catalog.String("no debe estar vacío"),
+	issers.CodeURL:            catalog.String("debe ser una URL pero no lo es porque %s"),
+}
@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// french are the French translations of the default messages
+var french = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("doit être vrai"),
+	issers.CodeFalse:    catalog.String("doit être faux"),
+	issers.CodeRequired: catalog.String("doit être renseigné"),
+
+	issers.CodeRequiredIf:      catalog.String("doit être renseigné pour la valeur actuelle de %s"),
+	issers.CodeRequiredUnless:  catalog.String("doit être renseigné sauf si %s permet de l'omettre"),
+	issers.CodeRequiredWith:    catalog.String("doit être renseigné lorsque %s est renseigné"),
+	issers.CodeRequiredWithout: catalog.String("doit être renseigné lorsque %s n'est pas renseigné"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("doit être renseigné lorsque %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("doit être renseigné sauf si %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("exactement un parmi %s doit être renseigné"),
+	issers.CodeAtLeastOneOf:      catalog.String("au moins un parmi %s doit être renseigné"),
+	issers.CodeMutuallyExclusive: catalog.String("au plus un parmi %s peut être renseigné"),
+	issers.CodeConflict:          catalog.String("ne doit pas être renseigné avec %s"),
+
+	issers.CodeIntBetween:            catalog.String("doit être compris entre %d et %d"),
+	issers.CodeIntGreaterThan:        catalog.String("doit être supérieur à %d"),
+	issers.CodeIntLessThan:           catalog.String("doit être inférieur à %d"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("doit être supérieur ou égal à %d"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("doit être inférieur ou égal à %d"),
+
+	issers.CodeFloat64Between:            catalog.String("doit être compris entre %f et %f"),
+	issers.CodeFloat64GreaterThan:        catalog.String("doit être supérieur à %f"),
+	issers.CodeFloat64LessThan:           catalog.String("doit être inférieur à %f"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("doit être supérieur ou égal à %f"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("doit être inférieur ou égal à %f"),
+
+	issers.CodeBetween:            catalog.String("doit être compris entre %v et %v"),
+	issers.CodeGreaterThan:        catalog.String("doit être supérieur à %v"),
+	issers.CodeLessThan:           catalog.String("doit être inférieur à %v"),
+	issers.CodeGreaterThanOrEqual: catalog.String("doit être supérieur ou égal à %v"),
+	issers.CodeLessThanOrEqual:    catalog.String("doit être inférieur ou égal à %v"),
+
+	issers.CodeEqualToField:     catalog.String("doit être égal à %s"),
+	issers.CodeConfirmation:     catalog.String("doit correspondre à %s"),
+	issers.CodeAfterField:       catalog.String("doit être postérieur à %s"),
+	issers.CodeBeforeField:      catalog.String("doit être antérieur à %s"),
+	issers.CodeGreaterThanField: catalog.String("doit être supérieur à %s"),
+	issers.CodeLessThanField:    catalog.String("doit être inférieur à %s"),
+
+	issers.CodeTimeBefore:  catalog.String("doit être antérieur à %s"),
+	issers.CodeTimeAfter:   catalog.String("doit être postérieur à %s"),
+	issers.CodeTimeBetween: catalog.String("doit être compris entre %s et %s"),
+	issers.CodeTimeWithin:  catalog.String("doit être à moins de %v de maintenant"),
+	issers.CodeTimeLayout:  catalog.String("doit être une date au format %s"),
+
+	issers.CodeStringLengthBetween: characters(2,
+		"doit contenir entre %d et %d caractère",
+		"doit contenir entre %d et %d caractères"),
+	issers.CodeStringLengthGreaterThan: characters(1,
+		"doit contenir plus de %d caractère",
+		"doit contenir plus de %d caractères"),
+	issers.CodeStringLengthLessThan: characters(1,
+		"doit contenir moins de %d caractère",
+		"doit contenir moins de %d caractères"),
+	issers.CodeStringLengthGreaterThanOrEqual: characters(1,
+		"doit contenir au moins %d caractère",
+		"doit contenir au moins %d caractères"),
+	issers.CodeStringLengthLessThanOrEqual: characters(1,
+		"doit contenir au plus %d caractère",
+		"doit contenir au plus %d caractères"),
+
+	issers.CodeMatchingRegexp: catalog.String("n'est pas au bon format"),
+	issers.CodeEmail:          catalog.String("doit être une adresse e-mail valide"),
+	issers.CodeInStringSlice:  catalog.String("n'est pas une valeur acceptée"),
+	issers.CodeNotEmpty:       catalog.String("ne doit pas être vide"),
+	issers.CodeURL:            catalog.String("doit être une URL mais ne l'est pas car %s"),
+}
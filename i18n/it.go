@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// italian are the Italian translations of the default messages
+var italian = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("deve essere vero"),
+	issers.CodeFalse:    catalog.String("deve essere falso"),
+	issers.CodeRequired: catalog.String("deve essere presente"),
+
+	issers.CodeRequiredIf:      catalog.String("deve essere presente per il valore attuale di %s"),
+	issers.CodeRequiredUnless:  catalog.String("deve essere presente a meno che %s non consenta di ometterlo"),
+	issers.CodeRequiredWith:    catalog.String("deve essere presente quando %s è presente"),
+	issers.CodeRequiredWithout: catalog.String("deve essere presente quando %s non è presente"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("deve essere presente quando %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("deve essere presente a meno che %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("esattamente uno tra %s deve essere presente"),
+	issers.CodeAtLeastOneOf:      catalog.String("almeno uno tra %s deve essere presente"),
+	issers.CodeMutuallyExclusive: catalog.String("al massimo uno tra %s può essere presente"),
+	issers.CodeConflict:          catalog.String("non deve essere presente insieme a %s"),
+
+	issers.CodeIntBetween:            catalog.String("deve essere compreso tra %d e %d"),
+	issers.CodeIntGreaterThan:        catalog.String("deve essere maggiore di %d"),
+	issers.CodeIntLessThan:           catalog.String("deve essere minore di %d"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("deve essere maggiore o uguale a %d"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("deve essere minore o uguale a %d"),
+
+	issers.CodeFloat64Between:            catalog.String("deve essere compreso tra %f e %f"),
+	issers.CodeFloat64GreaterThan:        catalog.String("deve essere maggiore di %f"),
+	issers.CodeFloat64LessThan:           catalog.String("deve essere minore di %f"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("deve essere maggiore o uguale a %f"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("deve essere minore o uguale a %f"),
+
+	issers.CodeBetween:            catalog.String("deve essere compreso tra %v e %v"),
+	issers.CodeGreaterThan:        catalog.String("deve essere maggiore di %v"),
+	issers.CodeLessThan:           catalog.String("deve essere minore di %v"),
+	issers.CodeGreaterThanOrEqual: catalog.String("deve essere maggiore o uguale a %v"),
+	issers.CodeLessThanOrEqual:    catalog.String("deve essere minore o uguale a %v"),
+
+	issers.CodeEqualToField:     catalog.String("deve essere uguale a %s"),
+	issers.CodeConfirmation:     catalog.String("deve corrispondere a %s"),
+	issers.CodeAfterField:       catalog.String("deve essere successivo a %s"),
+	issers.CodeBeforeField:      catalog.String("deve essere precedente a %s"),
+	issers.CodeGreaterThanField: catalog.String("deve essere maggiore di %s"),
+	issers.CodeLessThanField:    catalog.String("deve essere minore di %s"),
+
+	issers.CodeTimeBefore:  catalog.String("deve essere precedente a %s"),
+	issers.CodeTimeAfter:   catalog.String("deve essere successivo a %s"),
+	issers.CodeTimeBetween: catalog.String("deve essere compreso tra %s e %s"),
+	issers.CodeTimeWithin:  catalog.String("deve essere entro %v da adesso"),
+	issers.CodeTimeLayout:  catalog.String("deve essere una data nel formato %s"),
+
+	issers.CodeStringLengthBetween: characters(2,
+		"deve contenere tra %d e %d carattere",
+		"deve contenere tra %d e %d caratteri"),
+	issers.CodeStringLengthGreaterThan: characters(1,
+		"deve contenere più di %d carattere",
+		"deve contenere più di %d caratteri"),
+	issers.CodeStringLengthLessThan: characters(1,
+		"deve contenere meno di %d carattere",
+		"deve contenere meno di %d caratteri"),
+	issers.CodeStringLengthGreaterThanOrEqual: characters(1,
+		"deve contenere almeno %d carattere",
+		"deve contenere almeno %d caratteri"),
+	issers.CodeStringLengthLessThanOrEqual: characters(1,
+		"deve contenere al massimo %d carattere",
+		"deve contenere al massimo %d caratteri"),
+
+	issers.CodeMatchingRegexp: catalog.String("non è nel formato corretto"),
+	issers.CodeEmail:          catalog.String("deve essere un indirizzo email valido"),
+	issers.CodeInStringSlice:  catalog.String("non è un valore accettabile"),
+	issers.CodeNotEmpty:       catalog.String("non deve essere vuoto"),
+	issers.CodeURL:            catalog.String("deve essere un URL ma non lo è perché %s"),
+}
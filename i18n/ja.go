@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// japanese are the Japanese translations of the default messages
+var japanese = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("真である必要があります"),
+	issers.CodeFalse:    catalog.String("偽である必要があります"),
+	issers.CodeRequired: catalog.String("必須です"),
+
+	issers.CodeRequiredIf:      catalog.String("%sの現在の値では必須です"),
+	issers.CodeRequiredUnless:  catalog.String("%sにより省略が許可されない限り必須です"),
+	issers.CodeRequiredWith:    catalog.String("%sが指定されている場合は必須です"),
+	issers.CodeRequiredWithout: catalog.String("%sが指定されていない場合は必須です"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("%sが%sの場合は必須です"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("%sが%sの場合を除き必須です"),
+
+	issers.CodeExactlyOneOf:      catalog.String("%sのうち1つだけを指定する必要があります"),
+	issers.CodeAtLeastOneOf:      catalog.String("%sのうち少なくとも1つを指定する必要があります"),
+	issers.CodeMutuallyExclusive: catalog.String("%sのうち指定できるのは1つまでです"),
+	issers.CodeConflict:          catalog.String("%sと同時に指定することはできません"),
+
+	issers.CodeIntBetween:            catalog.String("%dから%dの間である必要があります"),
+	issers.CodeIntGreaterThan:        catalog.String("%dより大きい必要があります"),
+	issers.CodeIntLessThan:           catalog.String("%dより小さい必要があります"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("%d以上である必要があります"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("%d以下である必要があります"),
+
+	issers.CodeFloat64Between:            catalog.String("%fから%fの間である必要があります"),
+	issers.CodeFloat64GreaterThan:        catalog.String("%fより大きい必要があります"),
+	issers.CodeFloat64LessThan:           catalog.String("%fより小さい必要があります"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("%f以上である必要があります"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("%f以下である必要があります"),
+
+	issers.CodeBetween:            catalog.String("%vから%vの間である必要があります"),
+	issers.CodeGreaterThan:        catalog.String("%vより大きい必要があります"),
+	issers.CodeLessThan:           catalog.String("%vより小さい必要があります"),
+	issers.CodeGreaterThanOrEqual: catalog.String("%v以上である必要があります"),
+	issers.CodeLessThanOrEqual:    catalog.String("%v以下である必要があります"),
+
+	issers.CodeEqualToField:     catalog.String("%sと等しい必要があります"),
+	issers.CodeConfirmation:     catalog.String("%sと一致する必要があります"),
+	issers.CodeAfterField:       catalog.String("%sより後である必要があります"),
+	issers.CodeBeforeField:      catalog.String("%sより前である必要があります"),
+	issers.CodeGreaterThanField: catalog.String("%sより大きい必要があります"),
+	issers.CodeLessThanField:    catalog.String("%sより小さい必要があります"),
+
+	issers.CodeTimeBefore:  catalog.String("%sより前である必要があります"),
+	issers.CodeTimeAfter:   catalog.String("%sより後である必要があります"),
+	issers.CodeTimeBetween: catalog.String("%sから%sの間である必要があります"),
+	issers.CodeTimeWithin:  catalog.String("現在から%v以内である必要があります"),
+	issers.CodeTimeLayout:  catalog.String("%sの形式の日時である必要があります"),
+
+	issers.CodeStringLengthBetween:            catalog.String("%d文字から%d文字の間である必要があります"),
+	issers.CodeStringLengthGreaterThan:        catalog.String("%d文字より長い必要があります"),
+	issers.CodeStringLengthLessThan:           catalog.String("%d文字より短い必要があります"),
+	issers.CodeStringLengthGreaterThanOrEqual: catalog.String("%d文字以上である必要があります"),
+	issers.CodeStringLengthLessThanOrEqual:    catalog.String("%d文字以下である必要があります"),
+
+	issers.CodeMatchingRegexp: catalog.String("形式が正しくありません"),
+	issers.CodeEmail:          catalog.String("有効なメールアドレスである必要があります"),
+	issers.CodeInStringSlice:  catalog.String("許可されていない値です"),
+	issers.CodeNotEmpty:       catalog.String("空にすることはできません"),
+	issers.CodeURL:            catalog.String("URLである必要がありますが、%sのため無効です"),
+}
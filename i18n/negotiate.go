@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Catalog returns the catalog used by PrinterForRequest and PrinterForAcceptLanguage.
+// It's created with NewBuilder the first time it's needed and shared afterwards.
+// To use a catalog with your own translations, create the printer with Negotiate:
+//
+// @example
+// ```go
+// p := message.NewPrinter(i18n.Negotiate(r.Header.Get("Accept-Language")), message.Catalog(myBuilder))
+// ```
+func Catalog() catalog.Catalog {
+	return sharedCatalog()
+}
+
+// sharedCatalog builds the catalog returned by Catalog only once
+var sharedCatalog = sync.OnceValue(func() catalog.Catalog {
+	return NewBuilder()
+})
+
+// Negotiate picks the supported language that best matches an Accept-Language header value.
+// DefaultLanguage is returned if the header is missing, malformed or matches nothing
+// @param acceptLanguage is the raw value of the Accept-Language header, e.g.: "de-CH, fr;q=0.8"
+// @param supported are the languages to choose from. If none are provided, Languages is used.
+//   DefaultLanguage is always supported
+func Negotiate(acceptLanguage string, supported ...language.Tag) language.Tag {
+	if len(supported) == 0 {
+		supported = Languages()
+	}
+	// The first tag given to the matcher is the one used when nothing matches
+	tags := make([]language.Tag, 0, len(supported)+1)
+	tags = append(tags, DefaultLanguage)
+	for _, tag := range supported {
+		if tag != DefaultLanguage {
+			tags = append(tags, tag)
+		}
+	}
+
+	preferred, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(preferred) == 0 {
+		return DefaultLanguage
+	}
+	_, index, confidence := language.NewMatcher(tags).Match(preferred...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return tags[index]
+}
+
+// PrinterForAcceptLanguage creates a printer for the supported language that best matches
+// an Accept-Language header value, using Catalog for the translations of the default messages
+// @param supported are the languages to choose from. See Negotiate
+func PrinterForAcceptLanguage(acceptLanguage string, supported ...language.Tag) *message.Printer {
+	return message.NewPrinter(Negotiate(acceptLanguage, supported...), message.Catalog(Catalog()))
+}
+
+// PrinterForRequest creates a printer for the supported language that best matches the
+// Accept-Language header of the request. See PrinterForAcceptLanguage
+//
+// @example
+// ```go
+// if is.HasErrors() {
+//   problem.New(is, i18n.PrinterForRequest(r)).Render(w)
+// }
+// ```
+func PrinterForRequest(r *http.Request, supported ...language.Tag) *message.Printer {
+	return PrinterForAcceptLanguage(r.Header.Get("Accept-Language"), supported...)
+}
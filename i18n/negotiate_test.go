@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/language"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := map[string]struct {
+		header    string
+		supported []language.Tag
+		expected  language.Tag
+	}{
+		"missing": {
+			header:   "",
+			expected: language.English,
+		},
+		"malformed": {
+			header:   "!!!",
+			expected: language.English,
+		},
+		"exact": {
+			header:   "pl",
+			expected: language.Polish,
+		},
+		"regional": {
+			header:   "de-CH, fr;q=0.8",
+			expected: language.German,
+		},
+		"weighted": {
+			header:   "nl, fr;q=0.8, de;q=0.5",
+			expected: language.French,
+		},
+		"unsupported": {
+			header:   "nl",
+			expected: language.English,
+		},
+		"restricted": {
+			header:    "de, fr;q=0.8",
+			supported: []language.Tag{language.French},
+			expected:  language.French,
+		},
+	}
+
+	for caseName, c := range cases {
+		if actual := Negotiate(c.header, c.supported...); actual != c.expected {
+			t.Errorf("%s: expected %s but got %s", caseName, c.expected, actual)
+		}
+	}
+}
+
+func TestPrinterForRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept-Language", "es-MX, en;q=0.5")
+	p := PrinterForRequest(r)
+	if actual := issers.ShouldBePresentErr.ErrorI18n(p); actual != "debe estar presente" {
+		t.Errorf(`expected "debe estar presente" but got "%s"`, actual)
+	}
+
+	r.Header.Del("Accept-Language")
+	p = PrinterForRequest(r)
+	if actual := issers.ShouldBePresentErr.ErrorI18n(p); actual != "should be present" {
+		t.Errorf(`expected "should be present" but got "%s"`, actual)
+	}
+}
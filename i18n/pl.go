@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/message/catalog"
+)
+
+// polish are the Polish translations of the default messages
+var polish = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("musi być prawdą"),
+	issers.CodeFalse:    catalog.String("musi być fałszem"),
+	issers.CodeRequired: catalog.String("jest wymagane"),
+
+	issers.CodeRequiredIf:      catalog.String("jest wymagane dla bieżącej wartości %s"),
+	issers.CodeRequiredUnless:  catalog.String("jest wymagane, chyba że %s pozwala je pominąć"),
+	issers.CodeRequiredWith:    catalog.String("jest wymagane, gdy podano %s"),
+	issers.CodeRequiredWithout: catalog.String("jest wymagane, gdy nie podano %s"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("jest wymagane, gdy %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("jest wymagane, chyba że %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("dokładnie jedno z %s musi być podane"),
+	issers.CodeAtLeastOneOf:      catalog.String("co najmniej jedno z %s musi być podane"),
+	issers.CodeMutuallyExclusive: catalog.String("co najwyżej jedno z %s może być podane"),
+	issers.CodeConflict:          catalog.String("nie może być podane razem z %s"),
+
+	issers.CodeIntBetween:            catalog.String("musi być pomiędzy %d a %d"),
+	issers.CodeIntGreaterThan:        catalog.String("musi być większe niż %d"),
+	issers.CodeIntLessThan:           catalog.String("musi być mniejsze niż %d"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("musi być większe lub równe %d"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("musi być mniejsze lub równe %d"),
+
+	issers.CodeFloat64Between:            catalog.String("musi być pomiędzy %f a %f"),
+	issers.CodeFloat64GreaterThan:        catalog.String("musi być większe niż %f"),
+	issers.CodeFloat64LessThan:           catalog.String("musi być mniejsze niż %f"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("musi być większe lub równe %f"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("musi być mniejsze lub równe %f"),
+
+	issers.CodeBetween:            catalog.String("musi być pomiędzy %v a %v"),
+	issers.CodeGreaterThan:        catalog.String("musi być większe niż %v"),
+	issers.CodeLessThan:           catalog.String("musi być mniejsze niż %v"),
+	issers.CodeGreaterThanOrEqual: catalog.String("musi być większe lub równe %v"),
+	issers.CodeLessThanOrEqual:    catalog.String("musi być mniejsze lub równe %v"),
+
+	issers.CodeEqualToField:     catalog.String("musi być równe %s"),
+	issers.CodeConfirmation:     catalog.String("musi być zgodne z %s"),
+	issers.CodeAfterField:       catalog.String("musi być późniejsze niż %s"),
+	issers.CodeBeforeField:      catalog.String("musi być wcześniejsze niż %s"),
+	issers.CodeGreaterThanField: catalog.String("musi być większe niż %s"),
+	issers.CodeLessThanField:    catalog.String("musi być mniejsze niż %s"),
+
+	issers.CodeTimeBefore:  catalog.String("musi być wcześniejsze niż %s"),
+	issers.CodeTimeAfter:   catalog.String("musi być późniejsze niż %s"),
+	issers.CodeTimeBetween: catalog.String("musi być pomiędzy %s a %s"),
+	issers.CodeTimeWithin:  catalog.String("musi być w ciągu %v od teraz"),
+	issers.CodeTimeLayout:  catalog.String("musi być datą w formacie %s"),
+
+	issers.CodeStringLengthBetween: catalog.String("musi mieć od %d do %d znaków"),
+	issers.CodeStringLengthGreaterThan: plural.Selectf(1, "%d",
+		"one", "musi mieć więcej niż %d znak",
+		"few", "musi mieć więcej niż %d znaki",
+		"other", "musi mieć więcej niż %d znaków"),
+	issers.CodeStringLengthLessThan: plural.Selectf(1, "%d",
+		"one", "musi mieć mniej niż %d znak",
+		"few", "musi mieć mniej niż %d znaki",
+		"other", "musi mieć mniej niż %d znaków"),
+	issers.CodeStringLengthGreaterThanOrEqual: plural.Selectf(1, "%d",
+		"one", "musi mieć co najmniej %d znak",
+		"few", "musi mieć co najmniej %d znaki",
+		"other", "musi mieć co najmniej %d znaków"),
+	issers.CodeStringLengthLessThanOrEqual: plural.Selectf(1, "%d",
+		"one", "może mieć co najwyżej %d znak",
+		"few", "może mieć co najwyżej %d znaki",
+		"other", "może mieć co najwyżej %d znaków"),
+
+	issers.CodeMatchingRegexp: catalog.String("ma nieprawidłowy format"),
+	issers.CodeEmail:          catalog.String("musi być prawidłowym adresem e-mail"),
+	issers.CodeInStringSlice:  catalog.String("nie jest dopuszczalną wartością"),
+	issers.CodeNotEmpty:       catalog.String("nie może być puste"),
+	issers.CodeURL:            catalog.String("musi być adresem URL, ale nie jest, ponieważ %s"),
+}
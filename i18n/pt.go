@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"github.com/wojnosystems/validates/issers"
+	"golang.org/x/text/message/catalog"
+)
+
+// portuguese are the Portuguese translations of the default messages
+var portuguese = map[string]catalog.Message{
+	issers.CodeTrue:     catalog.String("deve ser verdadeiro"),
+	issers.CodeFalse:    catalog.String("deve ser falso"),
+	issers.CodeRequired: catalog.String("deve estar presente"),
+
+	issers.CodeRequiredIf:      catalog.String("deve estar presente para o valor atual de %s"),
+	issers.CodeRequiredUnless:  catalog.String("deve estar presente, a menos que %s permita omiti-lo"),
+	issers.CodeRequiredWith:    catalog.String("deve estar presente quando %s estiver presente"),
+	issers.CodeRequiredWithout: catalog.String("deve estar presente quando %s não estiver presente"),
+
+	issers.RequiredIfConditionMsgID:     catalog.String("deve estar presente quando %s %s"),
+	issers.RequiredUnlessConditionMsgID: catalog.String("deve estar presente, a menos que %s %s"),
+
+	issers.CodeExactlyOneOf:      catalog.String("exatamente um de %s deve estar presente"),
+	issers.CodeAtLeastOneOf:      catalog.String("pelo menos um de %s deve estar presente"),
+	issers.CodeMutuallyExclusive: catalog.String("no máximo um de %s pode estar presente"),
+	issers.CodeConflict:          catalog.String("não deve estar presente junto com %s"),
+
+	issers.CodeIntBetween:            catalog.String("deve estar entre %d e %d"),
+	issers.CodeIntGreaterThan:        catalog.String("deve ser maior que %d"),
+	issers.CodeIntLessThan:           catalog.String("deve ser menor que %d"),
+	issers.CodeIntGreaterThanOrEqual: catalog.String("deve ser maior ou igual a %d"),
+	issers.CodeIntLessThanOrEqual:    catalog.String("deve ser menor ou igual a %d"),
+
+	issers.CodeFloat64Between:            catalog.String("deve estar entre %f e %f"),
+	issers.CodeFloat64GreaterThan:        catalog.String("deve ser maior que %f"),
+	issers.CodeFloat64LessThan:           catalog.String("deve ser menor que %f"),
+	issers.CodeFloat64GreaterThanOrEqual: catalog.String("deve ser maior ou igual a %f"),
+	issers.CodeFloat64LessThanOrEqual:    catalog.String("deve ser menor ou igual a %f"),
+
+	issers.CodeBetween:            catalog.String("deve estar entre %v e %v"),
+	issers.CodeGreaterThan:        catalog.String("deve ser maior que %v"),
+	issers.CodeLessThan:           catalog.String("deve ser menor que %v"),
+	issers.CodeGreaterThanOrEqual: catalog.String("deve ser maior ou igual a %v"),
+	issers.CodeLessThanOrEqual:    catalog.String("deve ser menor ou igual a %v"),
+
+	issers.CodeEqualToField:     catalog.String("deve ser igual a %s"),
+	issers.CodeConfirmation:     catalog.String("deve corresponder a %s"),
+	issers.CodeAfterField:       catalog.String("deve ser posterior a %s"),
+	issers.CodeBeforeField:      catalog.String("deve ser anterior a %s"),
+	issers.CodeGreaterThanField: catalog.String("deve ser maior que %s"),
+	issers.CodeLessThanField:    catalog.String("deve ser menor que %s"),
+
+	issers.CodeTimeBefore:  catalog.String("deve ser anterior a %s"),
+	issers.CodeTimeAfter:   catalog.String("deve ser posterior a %s"),
+	issers.CodeTimeBetween: catalog.String("deve estar entre %s e %s"),
+	issers.CodeTimeWithin:  catalog.String("deve estar a menos de %v de agora"),
+	issers.CodeTimeLayout:  catalog.String("deve ser uma data no formato %s"),
+
+	issers.CodeStringLengthBetween: characters(2,
+		"deve ter entre %d e %d caractere",
+		"deve ter entre %d e %d caracteres"),
+	issers.CodeStringLengthGreaterThan: characters(1,
+		"deve ter mais de %d caractere",
+		"deve ter mais de %d caracteres"),
+	issers.CodeStringLengthLessThan: characters(1,
+		"deve ter menos de %d caractere",
+		"deve ter menos de %d caracteres"),
+	issers.CodeStringLengthGreaterThanOrEqual: characters(1,
+		"deve ter pelo menos %d caractere",
+		"deve ter pelo menos %d caracteres"),
+	issers.CodeStringLengthLessThanOrEqual: characters(1,
+		"deve ter no máximo %d caractere",
+		"deve ter no máximo %d caracteres"),
+
+	issers.CodeMatchingRegexp: catalog.String("não está no formato correto"),
+	issers.CodeEmail:          catalog.String("deve ser um endereço de e-mail válido"),
+	issers.CodeInStringSlice:  catalog.String("não é um valor aceitável"),
+	issers.CodeNotEmpty:       catalog.String("não deve estar vazio"),
+	issers.CodeURL:            catalog.String("deve ser uma URL, mas não é porque %s"),
+}
@@ -12,3 +12,22 @@ type ValidateError interface {
 	// IsEqual returns true if the two ValidateErrors are the same type and contain the same data, false if not
 	IsEqual(ValidateError) bool
 }
+
+// Coder is implemented by ValidateErrors that carry a stable, machine-readable code,
+// e.g.: "int.between". Clients can use the code to tell errors apart without matching
+// on the (possibly translated) message. Implementing Coder is optional
+type Coder interface {
+	// Code returns the machine-readable identifier of the error
+	Code() string
+}
+
+// LabeledValidateError is implemented by ValidateErrors that can name the field they are about,
+// e.g.: "First name should be present" instead of "should be present". Renderers use it when
+// the field was given a label with Is.WithLabeledField or Is.Label. Implementing it is optional
+type LabeledValidateError interface {
+	ValidateError
+
+	// ErrorI18nLabeled is ErrorI18n, but with the subject of the message set to label.
+	// label is untranslated and should be translated with the printer, see issers.LabelMsgID
+	ErrorI18nLabeled(p *message.Printer, label string) string
+}
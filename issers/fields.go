@@ -0,0 +1,79 @@
+package issers
+
+import (
+	"cmp"
+	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/tree"
+	"time"
+)
+
+// The cross-field asserters compare the value at the current path with the
+// value of another field. The error is recorded at the current path and
+// refers to the other field by its path, see tree.Referencer. The path of
+// the other field is from the root, e.g.: for a sibling field use
+// is.CurrentPath().Up().DownField("password")
+
+// EqualToField creates an error unless value is equal to the value of the other field, as per ==.
+// Both values have the same type, so comparing values of different types doesn't compile.
+// NaN is never equal
+// @param otherPath is the path to the other field, from the root
+// @return true if valid (no errors added) false if not
+func EqualToField[T comparable](is *Is, value, otherValue T, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return is.True(value == otherValue, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeEqualToField(otherPath))
+	})
+}
+
+// ConfirmationOf creates an error unless value, e.g.: a password confirmation, is the same as the original
+// @param originalPath is the path to the original field, from the root
+// @return true if valid (no errors added) false if not
+//
+// @example
+// ```go
+// is.WithField("password_confirmation", func(is *Is) {
+//   is.ConfirmationOf(r.PasswordConfirmation, r.Password, tree.NewPath().DownField("password"), nil)
+// })
+// ```
+func (i *Is) ConfirmationOf(value, original string, originalPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.True(value == original, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeConfirmationOf(originalPath))
+	})
+}
+
+// AfterField creates an error unless value is after the time of the other field
+// @param otherPath is the path to the other field, from the root
+// @return true if valid (no errors added) false if not
+func (i *Is) AfterField(value, otherValue time.Time, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.True(value.After(otherValue), func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeAfterField(otherPath))
+	})
+}
+
+// BeforeField creates an error unless value is before the time of the other field
+// @param otherPath is the path to the other field, from the root
+// @return true if valid (no errors added) false if not
+func (i *Is) BeforeField(value, otherValue time.Time, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.True(value.Before(otherValue), func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeBeforeField(otherPath))
+	})
+}
+
+// GreaterThanField creates an error unless value is greater than the value of the other field.
+// NaN is never greater, like with GreaterThan
+// @param otherPath is the path to the other field, from the root
+// @return true if valid (no errors added) false if not
+func GreaterThanField[T cmp.Ordered](is *Is, value, otherValue T, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return is.True(otherValue < value, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeGreaterThanField(otherPath))
+	})
+}
+
+// LessThanField creates an error unless value is less than the value of the other field.
+// NaN is never less, like with LessThan
+// @param otherPath is the path to the other field, from the root
+// @return true if valid (no errors added) false if not
+func LessThanField[T cmp.Ordered](is *Is, value, otherValue T, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return is.True(value < otherValue, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeLessThanField(otherPath))
+	})
+}
@@ -0,0 +1,111 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/tree"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIs_CrossFieldAsserters(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	other := tree.NewPath().DownField("other")
+
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		expected ifaces.ValidateError
+	}{
+		"equal": {
+			validate: func(is *Is) bool { return EqualToField(is, "a", "a", other, nil) },
+		},
+		"not equal": {
+			validate: func(is *Is) bool { return EqualToField(is, 1, 2, other, nil) },
+			expected: NewShouldBeEqualToField(other),
+		},
+		"equal typed": {
+			validate: func(is *Is) bool { return EqualToField(is, int64(3), 3, other, nil) },
+		},
+		"not equal NaN": {
+			validate: func(is *Is) bool { return EqualToField(is, math.NaN(), math.NaN(), other, nil) },
+			expected: NewShouldBeEqualToField(other),
+		},
+		"confirmed": {
+			validate: func(is *Is) bool { return is.ConfirmationOf("hunter2", "hunter2", other, nil) },
+		},
+		"not confirmed": {
+			validate: func(is *Is) bool { return is.ConfirmationOf("hunter3", "hunter2", other, nil) },
+			expected: NewShouldBeConfirmationOf(other),
+		},
+		"after": {
+			validate: func(is *Is) bool { return is.AfterField(end, start, other, nil) },
+		},
+		"not after": {
+			validate: func(is *Is) bool { return is.AfterField(start, start, other, nil) },
+			expected: NewShouldBeAfterField(other),
+		},
+		"before": {
+			validate: func(is *Is) bool { return is.BeforeField(start, end, other, nil) },
+		},
+		"not before": {
+			validate: func(is *Is) bool { return is.BeforeField(end, start, other, nil) },
+			expected: NewShouldBeBeforeField(other),
+		},
+		"greater than": {
+			validate: func(is *Is) bool { return GreaterThanField(is, uint16(2), 1, other, nil) },
+		},
+		"not greater than": {
+			validate: func(is *Is) bool { return GreaterThanField(is, uint16(1), 1, other, nil) },
+			expected: NewShouldBeGreaterThanField(other),
+		},
+		"less than": {
+			validate: func(is *Is) bool { return LessThanField(is, "a", "b", other, nil) },
+		},
+		"not less than": {
+			validate: func(is *Is) bool { return LessThanField(is, "b", "a", other, nil) },
+			expected: NewShouldBeLessThanField(other),
+		},
+		"NaN less than": {
+			validate: func(is *Is) bool { return LessThanField(is, math.NaN(), 1, other, nil) },
+			expected: NewShouldBeLessThanField(other),
+		},
+		"NaN greater than": {
+			validate: func(is *Is) bool { return GreaterThanField(is, 1, math.NaN(), other, nil) },
+			expected: NewShouldBeGreaterThanField(other),
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		valid := c.validate(is)
+		if c.expected == nil {
+			if !valid || is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if valid || !is.Errors().IsErrorAt(tree.NewPath(), c.expected) {
+			t.Errorf("%s: expected error %v", caseName, c.expected)
+		}
+	}
+}
+
+func TestShouldBeFieldMsg(t *testing.T) {
+	password := tree.NewPath().DownField("account").DownField("password")
+	msg := NewShouldBeConfirmationOf(password)
+
+	if actual := msg.ErrorI18n(defTestMessagePrinter); actual != "should match account.password" {
+		t.Errorf(`expected "should match account.password" but got "%s"`, actual)
+	}
+	if refs := msg.References(); len(refs) != 1 || !refs[0].IsEqual(password) {
+		t.Errorf("expected a reference to %s but got %v", password, refs)
+	}
+	if msg.Code() != CodeConfirmation {
+		t.Errorf(`expected code "%s" but got "%s"`, CodeConfirmation, msg.Code())
+	}
+	if msg.IsEqual(NewShouldBeConfirmationOf(tree.NewPath().DownField("password"))) {
+		t.Error("expected messages referencing different fields to differ")
+	}
+	var _ tree.Referencer = msg
+}
@@ -0,0 +1,94 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"sort"
+)
+
+// The group asserters check how many of a group of alternative fields, e.g.:
+// id, slug and external_ref, are present. The fields are children of the
+// current path. If the check fails, an error about the whole group is recorded
+// at the current path and, if too many fields are present, an error is
+// recorded at each of them. Fields are processed in sorted order
+//
+// @example
+// ```go
+// is.ExactlyOneOf(map[string]bool{
+//   "id":           r.ID != 0,
+//   "slug":         len(r.Slug) != 0,
+//   "external_ref": len(r.ExternalRef) != 0,
+// })
+// ```
+
+// ExactlyOneOf creates an error unless exactly 1 of the fields is present
+// @param fields are the names of the fields and whether they are present. Panics if empty
+// @return true if valid (no errors added) false if not
+func (i *Is) ExactlyOneOf(fields map[string]bool) bool {
+	if len(fields) == 0 {
+		panic("fields cannot be empty")
+	}
+	return i.group(fields, 1, 1, NewShouldBeExactlyOneOf)
+}
+
+// AtLeastOneOf creates an error unless at least 1 of the fields is present
+// @param fields are the names of the fields and whether they are present. Panics if empty
+// @return true if valid (no errors added) false if not
+func (i *Is) AtLeastOneOf(fields map[string]bool) bool {
+	if len(fields) == 0 {
+		panic("fields cannot be empty")
+	}
+	return i.group(fields, 1, len(fields), NewShouldBeAtLeastOneOf)
+}
+
+// MutuallyExclusive creates an error if more than 1 of the fields is present
+// @param fields are the names of the fields and whether they are present
+// @return true if valid (no errors added) false if not
+func (i *Is) MutuallyExclusive(fields map[string]bool) bool {
+	return i.group(fields, 0, 1, NewShouldBeMutuallyExclusive)
+}
+
+// group records msg unless between min and max (inclusive) of the fields are present.
+// If more than max are present, each present field also gets an error naming the others
+func (i *Is) group(fields map[string]bool, min, max int, msg func(fields []tree.Path) *ShouldBeGroupMsg) bool {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var present []string
+	for _, name := range names {
+		if fields[name] {
+			present = append(present, name)
+		}
+	}
+	if min <= len(present) && len(present) <= max {
+		return true
+	}
+
+	i.Invalid(msg(i.fieldPaths(names)))
+	if len(present) > max {
+		for _, name := range present {
+			others := make([]string, 0, len(present)-1)
+			for _, other := range present {
+				if other != name {
+					others = append(others, other)
+				}
+			}
+			conflict := NewShouldNotBePresentWith(i.fieldPaths(others))
+			i.WithField(name, func(is *Is) {
+				is.Invalid(conflict)
+			})
+		}
+	}
+	return false
+}
+
+// fieldPaths returns the paths to the fields, which are children of the current path
+func (i *Is) fieldPaths(names []string) []tree.Path {
+	paths := make([]tree.Path, 0, len(names))
+	for _, name := range names {
+		paths = append(paths, i.currentPath.DownField(name))
+	}
+	return paths
+}
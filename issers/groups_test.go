@@ -0,0 +1,116 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"testing"
+)
+
+func TestIs_ExactlyOneOf(t *testing.T) {
+	ref := tree.NewPath().DownField("ref")
+	id, slug, external := ref.DownField("id"), ref.DownField("slug"), ref.DownField("external_ref")
+	all := []tree.Path{external, id, slug}
+
+	cases := map[string]struct {
+		fields   map[string]bool
+		expected func() *tree.ErrorNode
+	}{
+		"one": {
+			fields: map[string]bool{"id": true, "slug": false, "external_ref": false},
+		},
+		"none": {
+			fields: map[string]bool{"id": false, "slug": false, "external_ref": false},
+			expected: func() *tree.ErrorNode {
+				e := tree.NewErrorNode(nil)
+				e.DownField("ref").Add(NewShouldBeExactlyOneOf(all))
+				return e
+			},
+		},
+		"two": {
+			fields: map[string]bool{"id": true, "slug": true, "external_ref": false},
+			expected: func() *tree.ErrorNode {
+				e := tree.NewErrorNode(nil)
+				e.DownField("ref").Add(NewShouldBeExactlyOneOf(all))
+				e.DownField("ref").DownField("id").Add(NewShouldNotBePresentWith([]tree.Path{slug}))
+				e.DownField("ref").DownField("slug").Add(NewShouldNotBePresentWith([]tree.Path{id}))
+				return e
+			},
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		var valid bool
+		is.WithField("ref", func(is *Is) {
+			valid = is.ExactlyOneOf(c.fields)
+		})
+		if c.expected == nil {
+			if !valid || is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if valid || !is.Errors().IsEqual(c.expected()) {
+			t.Errorf("%s: expected the group and field errors", caseName)
+		}
+	}
+}
+
+func TestIs_AtLeastOneOf(t *testing.T) {
+	is := NewRoot()
+	if !is.AtLeastOneOf(map[string]bool{"email": true, "phone": true}) || is.HasErrors() {
+		t.Error("expected both fields to be allowed")
+	}
+	if is.AtLeastOneOf(map[string]bool{"email": false, "phone": false}) {
+		t.Error("expected no fields to be invalid")
+	}
+	expected := NewShouldBeAtLeastOneOf([]tree.Path{
+		tree.NewPath().DownField("email"),
+		tree.NewPath().DownField("phone"),
+	})
+	if is.Len() != 1 || !is.Errors().IsErrorAt(tree.NewPath(), expected) {
+		t.Error("expected only the group error")
+	}
+	if actual := expected.ErrorI18n(defTestMessagePrinter); actual != "at least one of email, phone should be present" {
+		t.Errorf(`expected "at least one of email, phone should be present" but got "%s"`, actual)
+	}
+}
+
+func TestIs_MutuallyExclusive(t *testing.T) {
+	is := NewRoot()
+	if !is.MutuallyExclusive(map[string]bool{"card": false, "iban": false}) || is.HasErrors() {
+		t.Error("expected no fields to be allowed")
+	}
+	if is.MutuallyExclusive(map[string]bool{"card": true, "iban": true}) {
+		t.Error("expected both fields to be invalid")
+	}
+	if is.Len() != 3 {
+		t.Errorf("expected the group error and 2 field errors but got %d", is.Len())
+	}
+	conflict := NewShouldNotBePresentWith([]tree.Path{tree.NewPath().DownField("iban")})
+	if !is.Errors().IsErrorAt(tree.NewPath().DownField("card"), conflict) {
+		t.Error("expected card to conflict with iban")
+	}
+	if refs := conflict.References(); len(refs) != 1 || !refs[0].IsEqual(tree.NewPath().DownField("iban")) {
+		t.Errorf("expected a reference to /iban but got %v", refs)
+	}
+}
+
+func TestIs_GroupEmpty(t *testing.T) {
+	for caseName, group := range map[string]func(is *Is, fields map[string]bool) bool{
+		"exactly one of":  (*Is).ExactlyOneOf,
+		"at least one of": (*Is).AtLeastOneOf,
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic for an empty group", caseName)
+				}
+			}()
+			group(NewRoot(), map[string]bool{})
+		}()
+	}
+	is := NewRoot()
+	if !is.MutuallyExclusive(map[string]bool{}) || is.HasErrors() {
+		t.Error("expected an empty group to be mutually exclusive")
+	}
+}
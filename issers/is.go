@@ -1,12 +1,14 @@
 package issers
 
 import (
-	"fmt"
+	"context"
 	"github.com/wojnosystems/validates/ifaces"
 	"github.com/wojnosystems/validates/tree"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Validater describes how structures should behave
@@ -16,9 +18,21 @@ type Validater interface {
 	Validate(is *Is) (*Is, error)
 }
 
+// ValidaterContext describes structures whose validation
+// needs a context.Context, e.g.: to query a database with a
+// deadline. Nested structs that implement both ValidaterContext
+// and Validater are validated with ValidateContext
+type ValidaterContext interface {
+	// ValidateContext performs the validation on an object
+	// @return err should be ctx.Err() if validation was cut
+	//   short because the context was cancelled
+	ValidateContext(ctx context.Context, is *Is) (*Is, error)
+}
+
 // Is is where the validates.Is validation errors are stored
 // Is only writes, you cannot "undo" an error written to it.
-// You can only keep adding more errors. A structure is valid
+// You can only keep adding more errors, unless they were
+// written tentatively with Try. A structure is valid
 // if no ValidationErrors are reported. This is very much like
 // Rail's Validations objects
 type Is struct {
@@ -33,13 +47,55 @@ type Is struct {
 
 	// errorsCount is a summation of all of the errors
 	errorsCount int
+
+	// warningsCount is a summation of all of the warnings
+	warningsCount int
+
+	// severity is the severity Invalid records with. It's
+	// tree.SeverityWarning within AsWarnings
+	severity tree.Severity
+
+	// cursor holds the error nodes along currentPath that have
+	// been materialized so far. cursor[0] is errorsRoot and
+	// cursor[k] is the node for the first k segments of
+	// currentPath. It only grows when an error is recorded and
+	// is truncated as With returns, so nodes are never created
+	// unless an error is actually written
+	cursor []*tree.ErrorNode
+
+	// maxErrors is the most errors that will be recorded. 0 means no limit
+	maxErrors int
+
+	// truncated is true once an error was discarded or validation was
+	// skipped because maxErrors was reached
+	truncated bool
+
+	// ctx is the context validation is performed in. nil means
+	// context.Background
+	ctx context.Context
+
+	// parallelism is the most goroutines ParallelEach will use.
+	// 0 means runtime.GOMAXPROCS(0)
+	parallelism int
+
+	// now returns the current time. nil means time.Now
+	now func() time.Time
+
+	// currentLabel is the label of the field at currentPath, if any.
+	// It's copied to the error node when an error is recorded
+	currentLabel string
 }
 
 // NewRoot creates a new Is with the current path as the Root (/)
-func NewRoot() *Is {
-	return &Is{
+// @param opts configure the Is, e.g.: MaxErrors
+func NewRoot(opts ...Option) *Is {
+	i := &Is{
 		currentPath: tree.NewPath(),
 	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
 }
 
 // CurrentPath is the path we're currently set to
@@ -53,7 +109,17 @@ func (i Is) HasErrors() bool {
 	return i.errorsCount != 0
 }
 
-// Errors returns the errorsRoot of the errors
+// HasWarnings returns true if there is at least 1 warning. Warnings do not make HasErrors true
+func (i Is) HasWarnings() bool {
+	return i.warningsCount != 0
+}
+
+// WarningsLen returns the number of warnings
+func (i Is) WarningsLen() int {
+	return i.warningsCount
+}
+
+// Errors returns the errorsRoot of the errors. The warnings are stored in the same tree
 func (i Is) Errors() *tree.ErrorNode {
 	return i.errors()
 }
@@ -72,6 +138,46 @@ func (i Is) Len() int {
 	return i.errorsCount
 }
 
+// Context returns the context the validation is being performed in.
+// This is context.Background unless WithContext was used
+func (i Is) Context() context.Context {
+	if i.ctx == nil {
+		return context.Background()
+	}
+	return i.ctx
+}
+
+// Now returns the current time, as per the function set with Clock
+func (i Is) Now() time.Time {
+	if i.now == nil {
+		return time.Now()
+	}
+	return i.now()
+}
+
+// Truncated returns true if the results are incomplete because the limit
+// set by MaxErrors or StopOnFirstError was reached: at least one error was
+// discarded, or a nested struct was not validated. Use this to tell the
+// user that there may be more errors than those reported
+func (i Is) Truncated() bool {
+	return i.truncated
+}
+
+// isFull returns true if no more errors can be recorded
+func (i Is) isFull() bool {
+	return i.maxErrors > 0 && i.errorsCount >= i.maxErrors
+}
+
+// skipIfFull returns true, and marks the results as truncated, if no more errors can be recorded.
+// Use this to avoid performing validations whose errors would be discarded
+func (i *Is) skipIfFull() bool {
+	if i.isFull() {
+		i.truncated = true
+		return true
+	}
+	return false
+}
+
 // ValidStructField performs validation on a nested struct field
 // @param fieldName of this structure. If you have a nested struct
 //   with json struct tag with name "thing" then "thing" should be
@@ -80,8 +186,14 @@ func (i Is) Len() int {
 // @return err if there was a problem validating input for some
 //   reason that cause validation to stop prematurely
 func (i *Is) ValidStructField(fieldName string, validator Validater) (err error) {
+	if i.skipIfFull() {
+		return nil
+	}
+	if err = i.Context().Err(); err != nil {
+		return err
+	}
 	i.WithField(fieldName, func(is *Is) {
-		_, err = validator.Validate(i)
+		err = is.validate(validator)
 	})
 	return err
 }
@@ -93,12 +205,53 @@ func (i *Is) ValidStructField(fieldName string, validator Validater) (err error)
 // @return err if there was a problem validating input for some
 //   reason that cause validation to stop prematurely
 func (i *Is) ValidStructIndex(index int, validator Validater) (err error) {
+	if i.skipIfFull() {
+		return nil
+	}
+	if err = i.Context().Err(); err != nil {
+		return err
+	}
 	i.WithIndex(index, func(is *Is) {
-		_, err = validator.Validate(i)
+		err = is.validate(validator)
 	})
 	return err
 }
 
+// ValidStructKey performs validation on a nested struct stored in a map
+// @param key of this structure in the map. Maps with non-string keys,
+//   such as map[int]T, should format the key, e.g.: strconv.Itoa
+// @param validator is a structure to recurse into and test for validation errors
+// @return err if there was a problem validating input for some
+//   reason that cause validation to stop prematurely
+func (i *Is) ValidStructKey(key string, validator Validater) (err error) {
+	if i.skipIfFull() {
+		return nil
+	}
+	if err = i.Context().Err(); err != nil {
+		return err
+	}
+	i.WithKey(key, func(is *Is) {
+		err = is.validate(validator)
+	})
+	return err
+}
+
+// validate runs the validator at the current path, using
+// ValidateContext if the validator implements ValidaterContext
+// @return err the error returned by the validator or, if the
+//   validator did not return one, the context's error
+func (i *Is) validate(validator Validater) (err error) {
+	if vc, ok := validator.(ValidaterContext); ok {
+		_, err = vc.ValidateContext(i.Context(), i)
+	} else {
+		_, err = validator.Validate(i)
+	}
+	if err == nil {
+		err = i.Context().Err()
+	}
+	return err
+}
+
 // ValidEachStruct performs validation on a nested struct at each index
 // This is a convenience method for WithField + ValidStructIndex
 // @return err an error that caused validation to stop prematurely
@@ -108,6 +261,9 @@ func (i *Is) ValidStructIndex(index int, validator Validater) (err error) {
 func (i *Is) ValidEachStruct(fieldName string, values []Validater) (err error) {
 	i.WithField(fieldName, func(is *Is) {
 		for idx, value := range values {
+			if is.skipIfFull() {
+				break
+			}
 			err = is.ValidStructIndex(idx, value)
 			if err != nil {
 				break
@@ -118,6 +274,35 @@ func (i *Is) ValidEachStruct(fieldName string, values []Validater) (err error) {
 	return err
 }
 
+// ValidEachMapValue performs validation on a nested struct at each key in the map
+// This is a convenience method for WithField + ValidStructKey. Keys are
+// visited in sorted order so that errors are reported in the same order on every run
+// @return err an error that caused validation to stop prematurely
+//   if any struct returns this value, no further validation will be
+//   performed and the error will be returned along with any validations
+//   performed at the time the error was returned
+func (i *Is) ValidEachMapValue(fieldName string, values map[string]Validater) (err error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	i.WithField(fieldName, func(is *Is) {
+		for _, key := range keys {
+			if is.skipIfFull() {
+				break
+			}
+			err = is.ValidStructKey(key, values[key])
+			if err != nil {
+				break
+			}
+		}
+	})
+
+	return err
+}
+
 // WithField is a convenience method to group fields together
 // it's called with a function context because when that
 // function completes, the current path in receiver is
@@ -125,10 +310,39 @@ func (i *Is) ValidEachStruct(fieldName string, values []Validater) (err error) {
 func (i *Is) WithField(fieldName string, wrap func(is *Is)) {
 	i.With(func() {
 		i.currentPath = i.currentPath.DownField(fieldName)
+		i.currentLabel = ""
 		wrap(i)
 	})
 }
 
+// WithLabeledField is WithField, but also names the field with a
+// human-readable label. See Label
+//
+// @example
+// ```go
+// is.WithLabeledField("firstName", "First name", func(is *Is) {
+//   is.StringLengthBetween(firstName, 1, 32, nil)
+// })
+// ```
+func (i *Is) WithLabeledField(fieldName, label string, wrap func(is *Is)) {
+	i.WithField(fieldName, func(is *Is) {
+		is.Label(label)
+		wrap(is)
+	})
+}
+
+// Label names the field at the current path. Label-aware errors recorded at
+// the current path, before or after calling Label, use it as their subject,
+// e.g.: "First name should be present". The label lasts until the current path changes
+// @param label is translated by the printer used to render the errors, using the message ID
+//   returned by LabelMsgID
+func (i *Is) Label(label string) {
+	i.currentLabel = label
+	if len(i.cursor) == i.currentPath.Len()+1 {
+		i.cursor[len(i.cursor)-1].SetLabel(label)
+	}
+}
+
 // WithIndex is a convenience method to group fields together
 // it's called with a function context because when that
 // function completes, the current path in receiver is
@@ -136,6 +350,20 @@ func (i *Is) WithField(fieldName string, wrap func(is *Is)) {
 func (i *Is) WithIndex(index int, wrap func(is *Is)) {
 	i.With(func() {
 		i.currentPath = i.currentPath.DownIndex(index)
+		i.currentLabel = ""
+		wrap(i)
+	})
+}
+
+// WithKey is a convenience method to group the validations of a
+// map value together. Any string may be used as a key.
+// it's called with a function context because when that
+// function completes, the current path in receiver is
+// reverted back to what it was before starting WithKey
+func (i *Is) WithKey(key string, wrap func(is *Is)) {
+	i.With(func() {
+		i.currentPath = i.currentPath.DownKey(key)
+		i.currentLabel = ""
 		wrap(i)
 	})
 }
@@ -144,26 +372,90 @@ func (i *Is) WithIndex(index int, wrap func(is *Is)) {
 // it's called with a function context because when that
 // function completes, the current path in receiver is
 // reverted back to what it was before starting With
+// If the context has been cancelled, wrap is not called
 func (i *Is) With(wrap func()) {
+	if i.Context().Err() != nil {
+		return
+	}
 	originalPath := i.currentPath
+	originalLabel := i.currentLabel
+	originalCursorLen := len(i.cursor)
 	defer func() {
 		i.currentPath = originalPath
+		i.currentLabel = originalLabel
+		if len(i.cursor) > originalCursorLen {
+			i.cursor = i.cursor[:originalCursorLen]
+		}
 	}()
 	wrap()
 }
 
-// currentErrorNode Builds and/or navigates to the current error node
-// Nodes are only created as currentErrorNode is used
-func (i *Is) currentErrorNode() (n *tree.ErrorNode) {
-	n = i.errors()
-	i.currentPath.EachComponent(func(fieldName string) bool {
-		n = n.DownField(fieldName)
-		return true
-	}, func(index int) bool {
-		n = n.DownIndex(index)
+// AbsorbAt merges the errors recorded in other into the receiver,
+// placing the root of other at path. This combines results that were
+// validated separately, e.g.: the headers, body and query of a request.
+// Errors keep their order and errors already recorded at the same
+// location (as per ValidateError.IsEqual) are not duplicated. Only as
+// many errors as the limit set by MaxErrors leaves room for are merged,
+// the rest are discarded and Truncated will return true. Warnings are
+// always merged
+// @param path is where to place other, relative to the root of the
+//   receiver. It does not depend on the current path
+// @param other the results to merge. It is not modified
+//
+// @example
+// ```go
+// is := issers.NewRoot()
+// is.AbsorbAt(tree.NewPath().DownField("body"), bodyIs)
+// is.AbsorbAt(tree.NewPath().DownField("query"), queryIs)
+// ```
+func (i *Is) AbsorbAt(path tree.Path, other *Is) {
+	i.absorbAt(path, other, true)
+}
+
+// absorbAt is the implementation of AbsorbAt
+// @param dedupe skips the errors of other that are already recorded at the same location.
+//   Without it, the result is the same as if other's errors were recorded in the receiver
+func (i *Is) absorbAt(path tree.Path, other *Is, dedupe bool) {
+	i.truncated = i.truncated || other.truncated
+	if other.errorsRoot == nil {
+		return
+	}
+	n := i.errors()
+	path.EachSegment(func(s tree.Segment) bool {
+		n = n.Down(s)
 		return true
 	})
-	return
+	var added, warningsAdded int
+	if i.maxErrors > 0 {
+		mergeAtMost := n.MergeAtMost
+		if !dedupe {
+			mergeAtMost = n.AppendAtMost
+		}
+		var dropped int
+		added, warningsAdded, dropped = mergeAtMost(other.errorsRoot, max(i.maxErrors-i.errorsCount, 0))
+		i.truncated = i.truncated || dropped != 0
+	} else if dedupe {
+		added, warningsAdded = n.Merge(other.errorsRoot)
+	} else {
+		added, warningsAdded = n.Append(other.errorsRoot)
+	}
+	i.errorsCount += added
+	i.warningsCount += warningsAdded
+}
+
+// currentErrorNode Builds and/or navigates to the current error node
+// Nodes are only created as currentErrorNode is used. Only the
+// segments of currentPath that are not yet in the cursor are
+// traversed, so repeated calls at the same path are O(1)
+func (i *Is) currentErrorNode() *tree.ErrorNode {
+	if len(i.cursor) == 0 {
+		i.cursor = append(i.cursor, i.errors())
+	}
+	for len(i.cursor) <= i.currentPath.Len() {
+		parent := i.cursor[len(i.cursor)-1]
+		i.cursor = append(i.cursor, parent.Down(i.currentPath.Segment(len(i.cursor)-1)))
+	}
+	return i.cursor[len(i.cursor)-1]
 }
 
 // Invalid is the input error assertion that states that some
@@ -172,14 +464,62 @@ func (i *Is) currentErrorNode() (n *tree.ErrorNode) {
 // be built upon this.
 // @param msg is the message to use. There is no default message
 //   for Invalid
+// If the limit set by MaxErrors has been reached, msg is discarded.
+// Within AsWarnings, msg is recorded as a warning instead. See Warn
 func (i *Is) Invalid(msg ifaces.ValidateError) {
+	if i.severity == tree.SeverityWarning {
+		i.Warn(msg)
+		return
+	}
+	if i.skipIfFull() {
+		return
+	}
 	i.errorsCount++
 	// Only creates the chain if we have an error
 	// We do not want to pre-allocate memory unless we know we're going to use it
 	n := i.currentErrorNode()
+	if len(i.currentLabel) != 0 {
+		n.SetLabel(i.currentLabel)
+	}
 	n.Add(msg)
 }
 
+// Warn records an advisory finding, e.g.: "password is weak", at the current path.
+// Warnings are stored alongside the errors but do not make HasErrors true
+// and are not limited by MaxErrors
+// @param msg is the message to use
+func (i *Is) Warn(msg ifaces.ValidateError) {
+	i.warningsCount++
+	n := i.currentErrorNode()
+	if len(i.currentLabel) != 0 {
+		n.SetLabel(i.currentLabel)
+	}
+	n.AddWarning(msg)
+}
+
+// AsWarnings records the findings of the assertions made within wrap as
+// warnings instead of errors. Every assertion has a warning variant this way
+//
+// @example
+// ```go
+// is.WithField("password", func(is *Is) {
+//   is.Required(len(password) != 0)
+//   is.AsWarnings(func(is *Is) {
+//     is.StringLengthGreaterThanOrEqual(password, 12, func() ifaces.ValidateError {
+//       return NewSimpleValidateError("is weak")
+//     })
+//   })
+// })
+// ```
+func (i *Is) AsWarnings(wrap func(is *Is)) {
+	originalSeverity := i.severity
+	defer func() {
+		i.severity = originalSeverity
+	}()
+	i.severity = tree.SeverityWarning
+	wrap(i)
+}
+
 // True ensures that value is true, otherwise it appends an error.
 // All other assertions can be built upon this.
 // Given the value, if the value is not true, the error message: msg
@@ -193,7 +533,10 @@ func (i *Is) Invalid(msg ifaces.ValidateError) {
 // @return true if valid (no errors added) false if not
 func (i *Is) True(value bool, msg func() ifaces.ValidateError) bool {
 	if !value {
-		i.Invalid(msgOrDefault(msg, ShouldBeTrueErr))
+		// Avoid building a message that would be discarded
+		if i.severity == tree.SeverityWarning || !i.skipIfFull() {
+			i.Invalid(msgOrDefault(msg, ShouldBeTrueErr))
+		}
 		return false
 	}
 	return true
@@ -247,6 +590,77 @@ func (i *Is) Required(isPresent bool) bool {
 	return true
 }
 
+// RequiredIf is Required, but the value is only required if condition is true, e.g.: the VAT ID
+// is required if the country is in the EU. The error refers to the field that controls the condition
+// @param condition is true if the value is required
+// @param conditionPath is the path, from the root, to the field that controls the condition
+// @param msg is the callback used to generate the message. Leave nil or return nil to use
+//   the default, which only names the field. Use NewShouldBePresentIfCondition to also
+//   describe the condition
+// @return true if valid (no errors added) false if not. Like Required, it's false if
+//   the value is missing, even if it's not required, so further validations can be skipped
+//
+// @example
+// ```go
+// is.WithField("vat_id", func(is *Is) {
+//   country := tree.NewPath().DownField("country")
+//   if is.RequiredIf(len(r.VATID) != 0, isEU(r.Country), country, func() ifaces.ValidateError {
+//     return issers.NewShouldBePresentIfCondition(country, "is in the EU")
+//   }) {
+//     is.MatchingRegexp(r.VATID, vatIDRegexp, nil)
+//   }
+// })
+// ```
+func (i *Is) RequiredIf(isPresent, condition bool, conditionPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.requiredBecause(isPresent, condition, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBePresentIf(conditionPath))
+	})
+}
+
+// RequiredUnless is Required, but the value is not required if condition is true, e.g.: the
+// shipping address is required unless it's the same as the billing address. See RequiredIf
+// @param condition is true if the value is NOT required
+// @param conditionPath is the path, from the root, to the field that controls the condition
+// @param msg is the callback used to generate the message. Leave nil or return nil to use
+//   the default. Use NewShouldBePresentUnlessCondition to also describe the condition
+func (i *Is) RequiredUnless(isPresent, condition bool, conditionPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.requiredBecause(isPresent, !condition, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBePresentUnless(conditionPath))
+	})
+}
+
+// RequiredWith is Required, but the value is only required if the other field is present. See RequiredIf
+// @param otherIsPresent is true if the other field is present
+// @param otherPath is the path, from the root, to the other field
+// @param msg is the callback used to generate the message. Leave nil or return nil to use the default
+func (i *Is) RequiredWith(isPresent, otherIsPresent bool, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.requiredBecause(isPresent, otherIsPresent, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBePresentWith(otherPath))
+	})
+}
+
+// RequiredWithout is Required, but the value is only required if the other field is NOT present. See RequiredIf
+// @param otherIsPresent is true if the other field is present
+// @param otherPath is the path, from the root, to the other field
+// @param msg is the callback used to generate the message. Leave nil or return nil to use the default
+func (i *Is) RequiredWithout(isPresent, otherIsPresent bool, otherPath tree.Path, msg func() ifaces.ValidateError) bool {
+	return i.requiredBecause(isPresent, !otherIsPresent, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBePresentWithout(otherPath))
+	})
+}
+
+// requiredBecause records msg if the value is required but missing
+// @return true if the value is present
+func (i *Is) requiredBecause(isPresent, required bool, msg func() ifaces.ValidateError) bool {
+	if !isPresent {
+		if required {
+			i.True(false, msg)
+		}
+		return false
+	}
+	return true
+}
+
 // IntBetween creates an error unless string's length is between the provided values (inclusive)
 // @return true if valid (no errors added) false if not
 func (i *Is) IntBetween(value, low, high int, msg func() ifaces.ValidateError) bool {
@@ -340,9 +754,7 @@ func (i *Is) StringLengthBetween(value string, low, high int, msg func() ifaces.
 		panic("low cannot be greater than high")
 	}
 	return i.IntBetween(len(value), low, high, func() ifaces.ValidateError {
-		defMsg := NewShouldBeIntBetween(low, high)
-		defMsg.MsgFmt = fmt.Sprintf("length %s", defMsg.MsgFmt)
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeStringLengthBetween(low, high))
 	})
 }
 
@@ -350,9 +762,7 @@ func (i *Is) StringLengthBetween(value string, low, high int, msg func() ifaces.
 // @return true if valid (no errors added) false if not
 func (i *Is) StringLengthGreaterThan(value string, low int, msg func() ifaces.ValidateError) bool {
 	return i.IntGreaterThan(len(value), low, func() ifaces.ValidateError {
-		defMsg := NewShouldBeIntGreaterThan(low)
-		defMsg.MsgFmt = fmt.Sprintf("length %s", defMsg.MsgFmt)
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeStringLengthGreaterThan(low))
 	})
 }
 
@@ -360,9 +770,7 @@ func (i *Is) StringLengthGreaterThan(value string, low int, msg func() ifaces.Va
 // @return true if valid (no errors added) false if not
 func (i *Is) StringLengthLessThan(value string, high int, msg func() ifaces.ValidateError) bool {
 	return i.IntLessThan(len(value), high, func() ifaces.ValidateError {
-		defMsg := NewShouldBeIntGreaterThan(high)
-		defMsg.MsgFmt = fmt.Sprintf("length %s", defMsg.MsgFmt)
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeStringLengthLessThan(high))
 	})
 }
 
@@ -370,9 +778,7 @@ func (i *Is) StringLengthLessThan(value string, high int, msg func() ifaces.Vali
 // @return true if valid (no errors added) false if not
 func (i *Is) StringLengthGreaterThanOrEqual(value string, low int, msg func() ifaces.ValidateError) bool {
 	return i.IntGreaterThanOrEqual(len(value), low, func() ifaces.ValidateError {
-		defMsg := NewShouldBeIntGreaterThanOrEqual(low)
-		defMsg.MsgFmt = fmt.Sprintf("length %s", defMsg.MsgFmt)
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeStringLengthGreaterThanOrEqual(low))
 	})
 }
 
@@ -380,9 +786,7 @@ func (i *Is) StringLengthGreaterThanOrEqual(value string, low int, msg func() if
 // @return true if valid (no errors added) false if not
 func (i *Is) StringLengthLessThanOrEqual(value string, high int, msg func() ifaces.ValidateError) bool {
 	return i.IntLessThanOrEqual(len(value), high, func() ifaces.ValidateError {
-		defMsg := NewShouldBeIntGreaterThanOrEqual(high)
-		defMsg.MsgFmt = fmt.Sprintf("length %s", defMsg.MsgFmt)
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeStringLengthLessThanOrEqual(high))
 	})
 }
 
@@ -390,7 +794,7 @@ func (i *Is) StringLengthLessThanOrEqual(value string, high int, msg func() ifac
 // @return true if valid (no errors added) false if not
 func (i *Is) StringNotEmpty(value string, msg func() ifaces.ValidateError) bool {
 	return i.True(len(value) != 0, func() ifaces.ValidateError {
-		return NewShouldBeNotEmpty()
+		return msgOrDefault(msg, NewShouldBeNotEmpty())
 	})
 }
 
@@ -419,9 +823,7 @@ func (i *Is) MatchingRegexp(value string, reg *regexp.Regexp, msg func() ifaces.
 // @return true if valid (no errors added) false if not
 func (i *Is) EmailAddress(value string, msg func() ifaces.ValidateError) bool {
 	return i.MatchingRegexp(value, emailRegexpCompiled, func() ifaces.ValidateError {
-		defMsg := NewShouldMatchingRegexp()
-		defMsg.MsgFmt = shouldBeEmailMsg
-		return msgOrDefault(msg, defMsg)
+		return msgOrDefault(msg, NewShouldBeEmail())
 	})
 }
 
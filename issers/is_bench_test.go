@@ -0,0 +1,66 @@
+package issers
+
+import (
+	"strconv"
+	"testing"
+)
+
+const (
+	benchmarkDepth    = 10
+	benchmarkElements = 1000
+)
+
+// withDepth nests fn benchmarkDepth fields deep
+func withDepth(is *Is, depth int, fn func(is *Is)) {
+	if depth == 0 {
+		fn(is)
+		return
+	}
+	is.WithField("level"+strconv.Itoa(depth), func(is *Is) {
+		withDepth(is, depth-1, fn)
+	})
+}
+
+func BenchmarkIs_WithField(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		is := NewRoot()
+		withDepth(is, benchmarkDepth, func(is *Is) {
+			for i := 0; i < benchmarkElements; i++ {
+				is.WithIndex(i, func(is *Is) {
+					is.WithField("value", func(is *Is) {
+						is.True(true, nil)
+					})
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkIs_Invalid(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		is := NewRoot()
+		withDepth(is, benchmarkDepth, func(is *Is) {
+			for i := 0; i < benchmarkElements; i++ {
+				is.WithIndex(i, func(is *Is) {
+					is.WithField("value", func(is *Is) {
+						is.Invalid(ShouldBeTrueErr)
+					})
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkIs_InvalidSamePath(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		is := NewRoot()
+		withDepth(is, benchmarkDepth, func(is *Is) {
+			for i := 0; i < benchmarkElements; i++ {
+				is.Invalid(ShouldBeTrueErr)
+			}
+		})
+	}
+}
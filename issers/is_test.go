@@ -1,6 +1,7 @@
 package issers
 
 import (
+	"context"
 	"github.com/wojnosystems/validates/ifaces"
 	"github.com/wojnosystems/validates/tree"
 	"golang.org/x/text/language"
@@ -342,3 +343,615 @@ func TestIs_URL(t *testing.T) {
 		}
 	}
 }
+
+func TestIs_StringMessages(t *testing.T) {
+	custom := NewSimpleValidateError("should be a link")
+	cases := map[string]struct {
+		validate func(is *Is)
+		expected string
+	}{
+		"length less than": {
+			validate: func(is *Is) { is.StringLengthLessThan("zoey", 2, nil) },
+			expected: "length should be less than 2",
+		},
+		"length less than or equal": {
+			validate: func(is *Is) { is.StringLengthLessThanOrEqual("zoey", 2, nil) },
+			expected: "length should be less than or equal to 2",
+		},
+		"not empty custom": {
+			validate: func(is *Is) {
+				is.StringNotEmpty("", func() ifaces.ValidateError { return custom })
+			},
+			expected: "should be a link",
+		},
+		"empty url custom": {
+			validate: func(is *Is) {
+				is.URI("", func() ifaces.ValidateError { return custom })
+			},
+			expected: "should be a link",
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		c.validate(is)
+		errs := is.Errors().Errors()
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error but got %d", caseName, len(errs))
+			continue
+		}
+		actual := errs[0].ErrorI18n(defTestMessagePrinter)
+		if actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, caseName, c.expected, actual)
+		}
+	}
+}
+
+func TestIs_CurrentErrorNode(t *testing.T) {
+	is := NewRoot()
+	is.WithField("a", func(is *Is) {
+		is.WithField("b", func(is *Is) {
+			is.True(true, nil)
+		})
+	})
+	if is.errorsRoot != nil {
+		t.Error("expected no error nodes to be created when there are no errors")
+	}
+
+	is.WithField("a", func(is *Is) {
+		is.WithField("b", func(is *Is) {
+			is.Invalid(ShouldBeTrueErr)
+		})
+		is.WithIndex(2, func(is *Is) {
+			is.Invalid(ShouldBeTrueErr)
+			is.Invalid(ShouldBeFalseErr)
+		})
+		is.Invalid(ShouldBePresentErr)
+		is.WithField("b", func(is *Is) {
+			is.Invalid(ShouldBeFalseErr)
+		})
+	})
+	is.Invalid(ShouldBePresentErr)
+
+	expected := tree.NewErrorNode(nil)
+	expected.Add(ShouldBePresentErr)
+	a := expected.DownField("a")
+	a.Add(ShouldBePresentErr)
+	a.DownField("b").Add(ShouldBeTrueErr)
+	a.DownField("b").Add(ShouldBeFalseErr)
+	a.DownIndex(2).Add(ShouldBeTrueErr)
+	a.DownIndex(2).Add(ShouldBeFalseErr)
+
+	if is.Len() != 6 {
+		t.Errorf("expected 6 errors but got %d", is.Len())
+	}
+	if !expected.IsEqual(is.Errors()) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *is.Errors())
+	}
+}
+
+func TestIs_ValidEachMapValue(t *testing.T) {
+	is := NewRoot()
+	err := is.ValidEachMapValue("names", map[string]Validater{
+		"ok":   &testName{First: "chris", Last: "wojno"},
+		"a/b":  &testName{Last: "wojno"},
+		"x[1]": &testName{First: "chris"},
+	})
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	is.WithField("labels", func(is *Is) {
+		is.WithKey("app", func(is *Is) {
+			is.StringNotEmpty("", nil)
+		})
+	})
+
+	expected := tree.NewErrorNode(nil)
+	names := expected.DownField("names")
+	names.DownKey("a/b").DownField("first").Add(ShouldBePresentErr)
+	names.DownKey("x[1]").DownField("last").Add(ShouldBePresentErr)
+	expected.DownField("labels").DownKey("app").Add(NewShouldBeNotEmpty())
+
+	if !expected.IsEqual(is.Errors()) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *is.Errors())
+	}
+	if !is.Errors().HasErrorAt(tree.NewPath().DownField("names").DownKey("x[1]").DownField("last")) {
+		t.Error(`expected an error at /names["x[1]"]/last`)
+	}
+}
+
+func TestIs_MaxErrors(t *testing.T) {
+	invalidRoot := func() *testRoot {
+		g := goldenTestRoot()
+		g.Name.First = ""
+		g.Age = 15
+		g.Emails = []string{"nope", "nah"}
+		return g
+	}
+
+	cases := map[string]struct {
+		opts              []Option
+		expectedLen       int
+		expectedTruncated bool
+	}{
+		"unlimited": {
+			expectedLen: 4,
+		},
+		"stop on first": {
+			opts:              []Option{StopOnFirstError()},
+			expectedLen:       1,
+			expectedTruncated: true,
+		},
+		"capped": {
+			opts:              []Option{MaxErrors(3)},
+			expectedLen:       3,
+			expectedTruncated: true,
+		},
+		"cap not reached": {
+			opts:        []Option{MaxErrors(4)},
+			expectedLen: 4,
+		},
+	}
+
+	for caseName, c := range cases {
+		is, err := invalidRoot().Validate(NewRoot(c.opts...))
+		if err != nil {
+			t.Errorf("%s: not expecting an error", caseName)
+		}
+		if is.Len() != c.expectedLen {
+			t.Errorf("%s: expected %d errors but got %d", caseName, c.expectedLen, is.Len())
+		}
+		if is.Truncated() != c.expectedTruncated {
+			t.Errorf("%s: expected truncated to be %s", caseName, boolToString(c.expectedTruncated))
+		}
+		if !is.HasErrors() {
+			t.Errorf("%s: expected errors", caseName)
+		}
+	}
+}
+
+func TestIs_StopOnFirstErrorSkipsStructs(t *testing.T) {
+	built := 0
+	msg := func() ifaces.ValidateError {
+		built++
+		return nil
+	}
+	is := NewRoot(StopOnFirstError())
+	is.WithField("age", func(is *Is) {
+		is.IntGreaterThan(1, 2, msg)
+	})
+	is.IntGreaterThan(1, 2, msg)
+	if built != 1 {
+		t.Errorf("expected the message to only be built for the first error, but was built %d times", built)
+	}
+	err := is.ValidEachStruct("names", []Validater{&testName{}, &testName{}})
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	if is.Len() != 1 || !is.Truncated() {
+		t.Errorf("expected 1 truncated error but got %d", is.Len())
+	}
+	if is.Errors().NamedChildren["names"] != nil {
+		t.Error("expected names not to be validated")
+	}
+}
+
+type testContextKey struct{}
+
+func TestIs_Context(t *testing.T) {
+	ctx := context.WithValue(context.Background(), testContextKey{}, "zoey")
+	is := NewRoot(WithContext(ctx))
+	called := false
+	is.WithField("name", func(is *Is) {
+		called = true
+		if is.Context().Value(testContextKey{}) != "zoey" {
+			t.Error("expected the context to be reachable from WithField")
+		}
+	})
+	if !called {
+		t.Error("expected WithField to be called")
+	}
+
+	if NewRoot().Context() == nil {
+		t.Error("expected a background context by default")
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	is = NewRoot(WithContext(cancelled))
+	is.WithField("name", func(is *Is) {
+		t.Error("expected WithField to be skipped once cancelled")
+	})
+	if err := is.ValidStructField("name", &testName{}); err != context.Canceled {
+		t.Errorf("expected context.Canceled but got: %v", err)
+	}
+}
+
+func TestIs_AbsorbAt(t *testing.T) {
+	body, err := (&testRoot{}).Validate(NewRoot())
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	query := NewRoot()
+	query.WithField("page", func(is *Is) {
+		is.IntGreaterThan(0, 0, nil)
+	})
+
+	is := NewRoot()
+	is.WithField("body", func(is *Is) {
+		is.WithField("age", func(is *Is) {
+			is.IntGreaterThanOrEqual(0, 18, nil)
+		})
+		// The path to absorb at does not depend on the current path
+		is.AbsorbAt(tree.NewPath().DownField("body"), body)
+	})
+	is.AbsorbAt(tree.NewPath().DownField("query"), query)
+
+	expected := tree.NewErrorNode(nil)
+	expected.DownField("body").Merge(body.Errors())
+	expected.DownField("query").DownField("page").Add(NewShouldBeIntGreaterThan(0))
+
+	if is.Len() != body.Len()+query.Len() {
+		t.Errorf("expected %d errors but got %d", body.Len()+query.Len(), is.Len())
+	}
+	if !expected.IsEqual(is.Errors()) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *is.Errors())
+	}
+}
+
+func TestIs_AbsorbAtMaxErrors(t *testing.T) {
+	other := NewRoot()
+	for idx := 0; idx < 10; idx++ {
+		other.WithIndex(idx, func(is *Is) {
+			is.IntGreaterThan(0, 0, nil)
+		})
+	}
+
+	is := NewRoot(MaxErrors(5))
+	is.WithField("name", func(is *Is) {
+		is.StringNotEmpty("", nil)
+	})
+	is.AbsorbAt(tree.NewPath().DownField("rows"), other)
+	if is.Len() != 5 {
+		t.Errorf("expected the cap of 5 errors to be honored but got %d", is.Len())
+	}
+	if !is.Truncated() {
+		t.Error("expected Truncated once errors were discarded")
+	}
+	for idx := 0; idx < 4; idx++ {
+		if !is.Errors().HasErrorAt(tree.NewPath().DownField("rows").DownIndex(idx)) {
+			t.Errorf("expected the first errors to be kept, missing rows[%d]", idx)
+		}
+	}
+}
+
+func TestIs_Codes(t *testing.T) {
+	cases := map[string]struct {
+		validate func(is *Is)
+		expected string
+	}{
+		"required": {
+			validate: func(is *Is) { is.Required(false) },
+			expected: CodeRequired,
+		},
+		"true": {
+			validate: func(is *Is) { is.True(false, nil) },
+			expected: CodeTrue,
+		},
+		"simple": {
+			validate: func(is *Is) { is.Invalid(NewSimpleValidateError("is odd")) },
+			expected: "",
+		},
+		"simple with a default message": {
+			validate: func(is *Is) { is.Invalid(NewSimpleValidateError("should be present")) },
+			expected: "",
+		},
+		"int between": {
+			validate: func(is *Is) { is.IntBetween(0, 1, 2, nil) },
+			expected: CodeIntBetween,
+		},
+		"string length less than": {
+			validate: func(is *Is) { is.StringLengthLessThan("zoey", 2, nil) },
+			expected: CodeStringLengthLessThan,
+		},
+		"email": {
+			validate: func(is *Is) { is.EmailAddress("zoey", nil) },
+			expected: CodeEmail,
+		},
+		"url": {
+			validate: func(is *Is) { is.URI("puppy", nil) },
+			expected: CodeURL,
+		},
+		"not empty": {
+			validate: func(is *Is) { is.StringNotEmpty("", nil) },
+			expected: CodeNotEmpty,
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		c.validate(is)
+		errs := is.Errors().Errors()
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error but got %d", caseName, len(errs))
+			continue
+		}
+		coder, ok := errs[0].(ifaces.Coder)
+		if !ok {
+			t.Errorf("%s: expected the error to implement ifaces.Coder", caseName)
+			continue
+		}
+		if coder.Code() != c.expected {
+			t.Errorf(`%s: expected code "%s" but got "%s"`, caseName, c.expected, coder.Code())
+		}
+	}
+}
+
+func TestIs_StringLengthMessages(t *testing.T) {
+	is := NewRoot()
+	is.StringLengthLessThan("zoey", 2, nil)
+	actual := is.Errors().Errors()[0].ErrorI18n(defTestMessagePrinter)
+	if actual != "length should be less than 2" {
+		t.Errorf(`expected "length should be less than 2" but got "%s"`, actual)
+	}
+}
+
+func TestIs_WithLabeledField(t *testing.T) {
+	is := NewRoot()
+	is.WithLabeledField("firstName", "First name", func(is *Is) {
+		is.StringLengthBetween("", 1, 32, nil)
+		is.WithField("initial", func(is *Is) {
+			is.Required(false)
+		})
+	})
+	is.WithField("lastName", func(is *Is) {
+		is.Required(false)
+	})
+
+	first := is.Errors().NamedChildren["firstName"]
+	if first.Label() != "First name" {
+		t.Errorf(`expected label "First name" but got "%s"`, first.Label())
+	}
+	actual := first.Messages(defTestMessagePrinter)
+	if len(actual) != 1 || actual[0] != "First name length should be between 1 and 32" {
+		t.Errorf(`expected the labeled message but got %v`, actual)
+	}
+	if label := first.NamedChildren["initial"].Label(); label != "" {
+		t.Errorf(`expected nested fields to not inherit the label but got "%s"`, label)
+	}
+	if label := is.Errors().NamedChildren["lastName"].Label(); label != "" {
+		t.Errorf(`expected the label to end with WithLabeledField but got "%s"`, label)
+	}
+}
+
+func TestIs_Label(t *testing.T) {
+	is := NewRoot()
+	is.WithField("firstName", func(is *Is) {
+		// Labels may be attached after the errors were recorded
+		is.Required(false)
+		is.Label("First name")
+	})
+	is.WithField("nickname", func(is *Is) {
+		// Labeling a field without errors does not create a node
+		is.Label("Nickname")
+	})
+
+	actual := is.Errors().NamedChildren["firstName"].Messages(defTestMessagePrinter)
+	if len(actual) != 1 || actual[0] != "First name should be present" {
+		t.Errorf(`expected the labeled message but got %v`, actual)
+	}
+	if _, ok := is.Errors().NamedChildren["nickname"]; ok {
+		t.Error("expected no node for a field without errors")
+	}
+}
+
+func TestShouldBeMsg_ErrorI18nLabeled(t *testing.T) {
+	msg := NewShouldBeStringLengthBetween(1, 32)
+	msg.LabeledMsgFmt = "%s must be between %d and %d characters"
+	actual := msg.ErrorI18nLabeled(defTestMessagePrinter, "First name")
+	if actual != "First name must be between 1 and 32 characters" {
+		t.Errorf(`expected the labeled format to be used but got "%s"`, actual)
+	}
+	if msg.IsEqual(NewShouldBeStringLengthBetween(1, 32)) {
+		t.Error("expected messages with different labeled formats to differ")
+	}
+}
+
+func TestShouldBeMsg_ErrorI18nLabeledPercent(t *testing.T) {
+	actual := NewShouldBeFloat64LessThanOrEqual(50).ErrorI18nLabeled(defTestMessagePrinter, "Discount %")
+	if actual != "Discount % should be less than or equal to 50.000000" {
+		t.Errorf(`expected the label to be written as-is but got "%s"`, actual)
+	}
+	actual = ShouldBePresentErr.ErrorI18nLabeled(defTestMessagePrinter, "Discount %")
+	if actual != "Discount % should be present" {
+		t.Errorf(`expected the label to be written as-is but got "%s"`, actual)
+	}
+}
+
+func TestIs_Warn(t *testing.T) {
+	is := NewRoot(StopOnFirstError())
+	is.WithField("password", func(is *Is) {
+		is.Warn(NewSimpleValidateError("is weak"))
+		is.Required(false)
+		is.Warn(NewSimpleValidateError("is common"))
+	})
+
+	if is.Len() != 1 || !is.HasErrors() {
+		t.Errorf("expected 1 error but got %d", is.Len())
+	}
+	if is.WarningsLen() != 2 || !is.HasWarnings() {
+		t.Errorf("expected 2 warnings, not limited by MaxErrors, but got %d", is.WarningsLen())
+	}
+	if actual := is.Errors().NamedChildren["password"].Warnings(); len(actual) != 2 {
+		t.Errorf("expected 2 warnings on the field but got %d", len(actual))
+	}
+}
+
+func TestIs_AsWarnings(t *testing.T) {
+	is := NewRoot()
+	is.WithField("username", func(is *Is) {
+		is.AsWarnings(func(is *Is) {
+			is.StringLengthGreaterThanOrEqual("zo", 3, nil)
+			is.WithField("deprecated", func(is *Is) {
+				is.True(false, nil)
+			})
+		})
+		is.Required(false)
+	})
+
+	if is.Len() != 1 {
+		t.Errorf("expected only the error recorded after AsWarnings but got %d", is.Len())
+	}
+	if is.WarningsLen() != 2 {
+		t.Errorf("expected 2 warnings but got %d", is.WarningsLen())
+	}
+	username := is.Errors().NamedChildren["username"]
+	if len(username.Warnings()) != 1 || !username.Warnings()[0].IsEqual(NewShouldBeStringLengthGreaterThanOrEqual(3)) {
+		t.Errorf("expected the length warning but got %v", username.Warnings())
+	}
+	if len(username.Errors()) != 1 || !username.Errors()[0].IsEqual(ShouldBePresentErr) {
+		t.Errorf("expected the required error but got %v", username.Errors())
+	}
+}
+
+func TestIs_AbsorbAtWarnings(t *testing.T) {
+	body := NewRoot()
+	body.WithField("name", func(is *Is) {
+		is.Warn(NewSimpleValidateError("is deprecated"))
+	})
+
+	is := NewRoot()
+	is.AbsorbAt(tree.NewPath().DownField("body"), body)
+	if is.HasErrors() {
+		t.Error("expected warnings to not be absorbed as errors")
+	}
+	if is.WarningsLen() != 1 {
+		t.Errorf("expected 1 warning but got %d", is.WarningsLen())
+	}
+}
+
+func TestIs_AbsorbAtWarningsWhenFull(t *testing.T) {
+	other := NewRoot()
+	other.WithField("name", func(is *Is) {
+		is.StringNotEmpty("", nil)
+		is.Warn(NewSimpleValidateError("is deprecated"))
+	})
+
+	is := NewRoot(StopOnFirstError())
+	is.StringNotEmpty("", nil)
+	is.AbsorbAt(tree.NewPath(), other)
+	if is.Len() != 1 {
+		t.Errorf("expected the cap of 1 error to be honored but got %d", is.Len())
+	}
+	if is.WarningsLen() != 1 {
+		t.Errorf("expected warnings to be absorbed once the cap is reached but got %d", is.WarningsLen())
+	}
+	if is.WarningsLen() != is.Errors().WarningsLen() {
+		t.Errorf("expected WarningsLen to be %d but got %d", is.Errors().WarningsLen(), is.WarningsLen())
+	}
+}
+
+func TestIs_ConditionalRequired(t *testing.T) {
+	other := tree.NewPath().DownField("other")
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		present  bool
+		expected ifaces.ValidateError
+	}{
+		"if: present": {
+			validate: func(is *Is) bool { return is.RequiredIf(true, true, other, nil) },
+			present:  true,
+		},
+		"if: missing and required": {
+			validate: func(is *Is) bool { return is.RequiredIf(false, true, other, nil) },
+			expected: NewShouldBePresentIf(other),
+		},
+		"if: missing and not required": {
+			validate: func(is *Is) bool { return is.RequiredIf(false, false, other, nil) },
+		},
+		"unless: missing and required": {
+			validate: func(is *Is) bool { return is.RequiredUnless(false, false, other, nil) },
+			expected: NewShouldBePresentUnless(other),
+		},
+		"unless: missing and not required": {
+			validate: func(is *Is) bool { return is.RequiredUnless(false, true, other, nil) },
+		},
+		"with: missing and required": {
+			validate: func(is *Is) bool { return is.RequiredWith(false, true, other, nil) },
+			expected: NewShouldBePresentWith(other),
+		},
+		"with: missing and not required": {
+			validate: func(is *Is) bool { return is.RequiredWith(false, false, other, nil) },
+		},
+		"without: missing and required": {
+			validate: func(is *Is) bool { return is.RequiredWithout(false, false, other, nil) },
+			expected: NewShouldBePresentWithout(other),
+		},
+		"without: present": {
+			validate: func(is *Is) bool { return is.RequiredWithout(true, false, other, nil) },
+			present:  true,
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		if actual := c.validate(is); actual != c.present {
+			t.Errorf("%s: expected %t but got %t", caseName, c.present, actual)
+		}
+		if c.expected == nil {
+			if is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if is.Len() != 1 || !is.Errors().IsErrorAt(tree.NewPath(), c.expected) {
+			t.Errorf("%s: expected error %v", caseName, c.expected)
+		}
+	}
+}
+
+func TestNewShouldBePresentUnless_Message(t *testing.T) {
+	sameAsBilling := tree.NewPath().DownField("same_as_billing")
+	actual := NewShouldBePresentUnless(sameAsBilling).ErrorI18n(defTestMessagePrinter)
+	if actual != "should be present unless same_as_billing allows it to be omitted" {
+		t.Errorf(`expected "should be present unless same_as_billing allows it to be omitted" but got "%s"`, actual)
+	}
+	actual = NewShouldBePresentUnlessCondition(sameAsBilling, "is checked").ErrorI18n(defTestMessagePrinter)
+	if actual != "should be present unless same_as_billing is checked" {
+		t.Errorf(`expected "should be present unless same_as_billing is checked" but got "%s"`, actual)
+	}
+}
+
+func TestIs_RequiredIfCondition(t *testing.T) {
+	country := tree.NewPath().DownField("country")
+	is := NewRoot()
+	is.WithLabeledField("vat_id", "VAT ID", func(is *Is) {
+		is.RequiredIf(false, true, country, func() ifaces.ValidateError {
+			return NewShouldBePresentIfCondition(country, "is in the EU")
+		})
+	})
+	vatID := tree.NewPath().DownField("vat_id")
+	if !is.Errors().IsErrorAt(vatID, NewShouldBePresentIfCondition(country, "is in the EU")) {
+		t.Fatal("expected the error with the condition")
+	}
+	if is.Errors().IsErrorAt(vatID, NewShouldBePresentIfCondition(country, "is in the EEA")) {
+		t.Error("expected errors with different conditions to differ")
+	}
+	msg := is.Errors().DownField("vat_id").Errors()[0]
+	if msg.(ifaces.Coder).Code() != CodeRequiredIf {
+		t.Errorf(`expected code "%s" but got "%s"`, CodeRequiredIf, msg.(ifaces.Coder).Code())
+	}
+	expected := "VAT ID should be present when country is in the EU"
+	if actual := msg.(ifaces.LabeledValidateError).ErrorI18nLabeled(defTestMessagePrinter, "VAT ID"); actual != expected {
+		t.Errorf(`expected "%s" but got "%s"`, expected, actual)
+	}
+}
+
+func TestSimpleValidateError_IsEqual(t *testing.T) {
+	if !NewSimpleValidateError("is odd").IsEqual(NewSimpleValidateError("is odd")) {
+		t.Error("expected errors with the same message to be equal")
+	}
+	if NewSimpleValidateError("should be present").IsEqual(ShouldBePresentErr) {
+		t.Error("expected an error with the message of a default error not to be equal to it")
+	}
+}
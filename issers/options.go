@@ -0,0 +1,57 @@
+package issers
+
+import (
+	"context"
+	"time"
+)
+
+// Option configures an Is when it's created with NewRoot
+type Option func(is *Is)
+
+// MaxErrors caps the number of errors that will be recorded. Once the cap
+// is reached, further errors are discarded, nested structs are no longer
+// validated and Truncated will return true. Values less than 1 mean there
+// is no cap, which is the default
+//
+// @example
+// ```go
+// is := issers.NewRoot(issers.MaxErrors(100))
+// ```
+func MaxErrors(max int) Option {
+	return func(is *Is) {
+		is.maxErrors = max
+	}
+}
+
+// StopOnFirstError stops recording errors and validating nested structs
+// as soon as the first error is recorded. This is the same as MaxErrors(1)
+func StopOnFirstError() Option {
+	return MaxErrors(1)
+}
+
+// WithContext sets the context the validation is performed in. Once the
+// context is cancelled, WithField, WithIndex, WithKey and With stop calling
+// their callbacks and the ValidStruct methods return ctx.Err(). Validations
+// can reach the context with Is.Context
+func WithContext(ctx context.Context) Option {
+	return func(is *Is) {
+		is.ctx = ctx
+	}
+}
+
+// Parallelism sets the number of goroutines ParallelEach may use. Values
+// less than 1 mean runtime.GOMAXPROCS(0), which is the default
+func Parallelism(workers int) Option {
+	return func(is *Is) {
+		is.parallelism = workers
+	}
+}
+
+// Clock sets the function the time asserters, e.g.: TimeWithin, use to
+// get the current time. This is time.Now unless Clock is used. Use it to
+// validate relative to a fixed time, e.g.: in tests
+func Clock(now func() time.Time) Option {
+	return func(is *Is) {
+		is.now = now
+	}
+}
@@ -0,0 +1,111 @@
+package issers
+
+import (
+	"cmp"
+	"github.com/wojnosystems/validates/ifaces"
+)
+
+// The ordered asserters work with any type that can be compared with < and >,
+// e.g.: int64, uint32, time.Duration or a string. They are functions rather
+// than methods of Is because Go methods cannot have type parameters.
+// Their default messages keep the type of the bounds, so a time.Duration
+// is written as "1m30s" rather than as a number of nanoseconds.
+// They use the < and <= operators, like Float64LessThan, so NaN is
+// never valid, whether it's the value or one of the bounds.
+//
+// Like the methods of Is, they take the Is and record errors right away.
+// The rules that check the same things, to combine with And, Or, etc., are
+// created with the functions of the same name suffixed with Rule, e.g.:
+// BetweenRule. Unlike the rules for the methods of Is, e.g.: IntBetween,
+// the rules cannot share the names of the asserters
+//
+// @example
+// ```go
+// is.WithField("timeout", func(is *issers.Is) {
+//   issers.Between(is, c.Timeout, time.Second, time.Minute, nil)
+// })
+//
+// var port = issers.BetweenRule[uint16](1024, 49151, nil)
+// issers.Check(is, c.Port, port)
+// ```
+
+// Between creates an error unless value is between the provided values (inclusive)
+// @return true if valid (no errors added) false if not
+func Between[T cmp.Ordered](is *Is, value, low, high T, msg func() ifaces.ValidateError) bool {
+	if high < low {
+		panic("low cannot be greater than high")
+	}
+	return is.True(low <= value && value <= high, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeBetween(low, high))
+	})
+}
+
+// GreaterThan creates an error unless value is greater than low
+// @return true if valid (no errors added) false if not
+func GreaterThan[T cmp.Ordered](is *Is, value, low T, msg func() ifaces.ValidateError) bool {
+	return is.True(low < value, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeGreaterThan(low))
+	})
+}
+
+// LessThan creates an error unless value is less than high
+// @return true if valid (no errors added) false if not
+func LessThan[T cmp.Ordered](is *Is, value, high T, msg func() ifaces.ValidateError) bool {
+	return is.True(value < high, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeLessThan(high))
+	})
+}
+
+// GreaterThanOrEqual creates an error unless value is greater than or equal to low
+// @return true if valid (no errors added) false if not
+func GreaterThanOrEqual[T cmp.Ordered](is *Is, value, low T, msg func() ifaces.ValidateError) bool {
+	return is.True(low <= value, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeGreaterThanOrEqual(low))
+	})
+}
+
+// LessThanOrEqual creates an error unless value is less than or equal to high
+// @return true if valid (no errors added) false if not
+func LessThanOrEqual[T cmp.Ordered](is *Is, value, high T, msg func() ifaces.ValidateError) bool {
+	return is.True(value <= high, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeLessThanOrEqual(high))
+	})
+}
+
+// BetweenRule creates a rule for Between
+func BetweenRule[T cmp.Ordered](low, high T, msg func() ifaces.ValidateError) Rule[T] {
+	if high < low {
+		panic("low cannot be greater than high")
+	}
+	return func(is *Is, value T) bool {
+		return Between(is, value, low, high, msg)
+	}
+}
+
+// GreaterThanRule creates a rule for GreaterThan
+func GreaterThanRule[T cmp.Ordered](low T, msg func() ifaces.ValidateError) Rule[T] {
+	return func(is *Is, value T) bool {
+		return GreaterThan(is, value, low, msg)
+	}
+}
+
+// LessThanRule creates a rule for LessThan
+func LessThanRule[T cmp.Ordered](high T, msg func() ifaces.ValidateError) Rule[T] {
+	return func(is *Is, value T) bool {
+		return LessThan(is, value, high, msg)
+	}
+}
+
+// GreaterThanOrEqualRule creates a rule for GreaterThanOrEqual
+func GreaterThanOrEqualRule[T cmp.Ordered](low T, msg func() ifaces.ValidateError) Rule[T] {
+	return func(is *Is, value T) bool {
+		return GreaterThanOrEqual(is, value, low, msg)
+	}
+}
+
+// LessThanOrEqualRule creates a rule for LessThanOrEqual
+func LessThanOrEqualRule[T cmp.Ordered](high T, msg func() ifaces.ValidateError) Rule[T] {
+	return func(is *Is, value T) bool {
+		return LessThanOrEqual(is, value, high, msg)
+	}
+}
@@ -0,0 +1,130 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBetween(t *testing.T) {
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		expected *ShouldBeMsg
+	}{
+		"int64 in range": {
+			validate: func(is *Is) bool { return Between(is, int64(5), 1, 5, nil) },
+		},
+		"int64 out of range": {
+			validate: func(is *Is) bool { return Between(is, int64(6), 1, 5, nil) },
+			expected: NewShouldBeBetween(int64(1), int64(5)),
+		},
+		"uint32 too low": {
+			validate: func(is *Is) bool { return Between(is, uint32(0), 1, 5, nil) },
+			expected: NewShouldBeBetween(uint32(1), uint32(5)),
+		},
+		"duration": {
+			validate: func(is *Is) bool { return Between(is, 2*time.Minute, time.Second, time.Minute, nil) },
+			expected: NewShouldBeBetween(time.Second, time.Minute),
+		},
+		"string": {
+			validate: func(is *Is) bool { return Between(is, "b", "a", "c", nil) },
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		valid := c.validate(is)
+		if c.expected == nil {
+			if !valid || is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if valid || !is.Errors().IsErrorAt(tree.NewPath(), c.expected) {
+			t.Errorf("%s: expected error %v", caseName, c.expected)
+		}
+	}
+}
+
+func TestOrderedAsserters(t *testing.T) {
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		valid    bool
+	}{
+		"greater than":              {validate: func(is *Is) bool { return GreaterThan(is, int64(2), 1, nil) }, valid: true},
+		"not greater than":          {validate: func(is *Is) bool { return GreaterThan(is, int64(1), 1, nil) }},
+		"less than":                 {validate: func(is *Is) bool { return LessThan(is, uint8(0), 1, nil) }, valid: true},
+		"not less than":             {validate: func(is *Is) bool { return LessThan(is, uint8(1), 1, nil) }},
+		"greater than or equal":     {validate: func(is *Is) bool { return GreaterThanOrEqual(is, time.Second, time.Second, nil) }, valid: true},
+		"not greater than or equal": {validate: func(is *Is) bool { return GreaterThanOrEqual(is, time.Millisecond, time.Second, nil) }},
+		"less than or equal":        {validate: func(is *Is) bool { return LessThanOrEqual(is, 1.5, 1.5, nil) }, valid: true},
+		"not less than or equal":    {validate: func(is *Is) bool { return LessThanOrEqual(is, 1.6, 1.5, nil) }},
+		"NaN greater than":          {validate: func(is *Is) bool { return GreaterThan(is, math.NaN(), 1.0, nil) }},
+		"greater than NaN":          {validate: func(is *Is) bool { return GreaterThan(is, 1.0, math.NaN(), nil) }},
+		"NaN less than":             {validate: func(is *Is) bool { return LessThan(is, math.NaN(), 1.0, nil) }},
+		"NaN greater than or equal": {validate: func(is *Is) bool { return GreaterThanOrEqual(is, math.NaN(), 1.0, nil) }},
+		"NaN less than or equal":    {validate: func(is *Is) bool { return LessThanOrEqual(is, math.NaN(), 1.0, nil) }},
+		"NaN between":               {validate: func(is *Is) bool { return Between(is, math.NaN(), 0.0, 1.0, nil) }},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		if actual := c.validate(is); actual != c.valid || is.HasErrors() == c.valid {
+			t.Errorf("%s: expected valid to be %t", caseName, c.valid)
+		}
+	}
+}
+
+func TestOrderedRules(t *testing.T) {
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		valid    bool
+	}{
+		"between":                   {validate: func(is *Is) bool { return Check(is, uint16(8080), BetweenRule[uint16](1024, 49151, nil)) }, valid: true},
+		"not between":               {validate: func(is *Is) bool { return Check(is, uint16(80), BetweenRule[uint16](1024, 49151, nil)) }},
+		"greater than":              {validate: func(is *Is) bool { return Check(is, int64(2), GreaterThanRule[int64](1, nil)) }, valid: true},
+		"not greater than":          {validate: func(is *Is) bool { return Check(is, int64(1), GreaterThanRule[int64](1, nil)) }},
+		"less than":                 {validate: func(is *Is) bool { return Check(is, "a", LessThanRule("b", nil)) }, valid: true},
+		"not less than":             {validate: func(is *Is) bool { return Check(is, "b", LessThanRule("b", nil)) }},
+		"greater than or equal":     {validate: func(is *Is) bool { return Check(is, time.Second, GreaterThanOrEqualRule(time.Second, nil)) }, valid: true},
+		"not greater than or equal": {validate: func(is *Is) bool { return Check(is, time.Millisecond, GreaterThanOrEqualRule(time.Second, nil)) }},
+		"less than or equal":        {validate: func(is *Is) bool { return Check(is, 1.5, LessThanOrEqualRule(1.5, nil)) }, valid: true},
+		"not less than or equal":    {validate: func(is *Is) bool { return Check(is, math.NaN(), LessThanOrEqualRule(1.5, nil)) }},
+		"combined": {
+			validate: func(is *Is) bool {
+				return Check(is, int32(-1), Or(LessThanRule[int32](0, nil), GreaterThanRule[int32](10, nil)))
+			},
+			valid: true,
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		if actual := c.validate(is); actual != c.valid || is.HasErrors() == c.valid {
+			t.Errorf("%s: expected valid to be %t", caseName, c.valid)
+		}
+	}
+}
+
+func TestBetweenRule_PanicsOnInvertedBounds(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	BetweenRule(5, 1, nil)
+}
+
+func TestNewShouldBeBetween_Message(t *testing.T) {
+	actual := NewShouldBeBetween(time.Second, 90*time.Second).ErrorI18n(defTestMessagePrinter)
+	if actual != "should be between 1s and 1m30s" {
+		t.Errorf(`expected "should be between 1s and 1m30s" but got "%s"`, actual)
+	}
+	if !NewShouldBeGreaterThan(int64(1)).IsEqual(NewShouldBeGreaterThan(int64(1))) {
+		t.Error("expected equal bounds to be equal")
+	}
+	if NewShouldBeGreaterThan(int64(1)).IsEqual(NewShouldBeGreaterThan(int32(1))) {
+		t.Error("expected bounds of different types to differ")
+	}
+}
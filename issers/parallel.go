@@ -0,0 +1,144 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelEach validates n elements of the array fieldName concurrently,
+// using at most the number of goroutines set by Parallelism. Each call to
+// fn gets its own Is, positioned at fieldName[idx], so fn must only record
+// errors through the Is it was given. Once every element is validated, the
+// errors are merged into the receiver in index order, so the resulting
+// errors are the same as if the elements were validated one after the other
+//
+// If fn returns an error for an element, elements after it are not merged
+// (and not validated, if they have not started) and the error for the
+// lowest index is returned, just like ValidEachStruct
+//
+// If a limit was set with MaxErrors, elements are validated sequentially
+// to honor the limit exactly
+//
+// If fn panics, no more elements are validated and the panic is raised again
+// on the calling goroutine once the running elements are done, so it can be
+// recovered by the caller. If the context is already cancelled, nothing is
+// validated and ctx.Err() is returned
+//
+// @example
+// ```go
+// err := is.ParallelEach("rows", len(r.Rows), func(idx int, is *issers.Is) error {
+//   _, err := r.Rows[idx].Validate(is)
+//   return err
+// })
+// ```
+// @return err an error that caused validation to stop prematurely
+func (i *Is) ParallelEach(fieldName string, n int, fn func(idx int, is *Is) error) (err error) {
+	if i.skipIfFull() {
+		return nil
+	}
+	if err = i.Context().Err(); err != nil {
+		return err
+	}
+	if i.maxErrors > 0 {
+		i.WithField(fieldName, func(is *Is) {
+			for idx := 0; idx < n && err == nil; idx++ {
+				if is.skipIfFull() {
+					break
+				}
+				is.WithIndex(idx, func(is *Is) {
+					err = fn(idx, is)
+				})
+			}
+		})
+		if err == nil {
+			err = i.Context().Err()
+		}
+		return err
+	}
+
+	i.WithField(fieldName, func(is *Is) {
+		children := make([]*Is, n)
+		errs := make([]error, n)
+
+		// firstErr is the lowest index that returned an error, or n if none have.
+		// It's -1 once fn panicked, which stops every worker
+		firstErr := int64(n)
+		next := int64(-1)
+		var panicked interface{}
+		var panicOnce sync.Once
+		var wg sync.WaitGroup
+		for w := 0; w < is.workers(n); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() {
+					if r := recover(); r != nil {
+						panicOnce.Do(func() {
+							panicked = r
+						})
+						atomic.StoreInt64(&firstErr, -1)
+					}
+				}()
+				for {
+					idx := int(atomic.AddInt64(&next, 1))
+					if idx >= n || int64(idx) > atomic.LoadInt64(&firstErr) {
+						return
+					}
+					child := is.fork()
+					child.currentPath = child.currentPath.DownIndex(idx)
+					children[idx] = child
+					if errs[idx] = fn(idx, child); errs[idx] != nil {
+						for {
+							current := atomic.LoadInt64(&firstErr)
+							if int64(idx) >= current || atomic.CompareAndSwapInt64(&firstErr, current, int64(idx)) {
+								break
+							}
+						}
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		if panicked != nil {
+			panic(panicked)
+		}
+
+		for idx := 0; idx < n && children[idx] != nil; idx++ {
+			is.absorbAt(tree.NewPath(), children[idx], false)
+			if errs[idx] != nil {
+				err = errs[idx]
+				break
+			}
+		}
+	})
+	if err == nil {
+		err = i.Context().Err()
+	}
+	return err
+}
+
+// workers returns the number of goroutines to use to process n items
+func (i Is) workers(n int) int {
+	w := i.parallelism
+	if w < 1 {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if w > n {
+		w = n
+	}
+	return w
+}
+
+// fork creates a new, empty Is at the receiver's current path that shares its configuration
+func (i Is) fork() *Is {
+	return &Is{
+		currentPath: i.currentPath,
+		maxErrors:   i.maxErrors,
+		ctx:         i.ctx,
+		parallelism: i.parallelism,
+		severity:    i.severity,
+		now:         i.now,
+	}
+}
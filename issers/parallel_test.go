@@ -0,0 +1,152 @@
+package issers
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func testParallelNames() []testName {
+	names := make([]testName, 50)
+	for idx := range names {
+		if idx%3 == 0 {
+			names[idx].Last = "wojno"
+		}
+		if idx%5 == 0 {
+			names[idx].First = "chris"
+		}
+	}
+	return names
+}
+
+func TestIs_ParallelEach(t *testing.T) {
+	names := testParallelNames()
+
+	sequential := NewRoot()
+	for idx := range names {
+		sequential.WithField("names", func(is *Is) {
+			_ = is.ValidStructIndex(idx, names[idx])
+		})
+	}
+
+	for _, workers := range []int{0, 1, 4, 100} {
+		parallel := NewRoot(Parallelism(workers))
+		err := parallel.ParallelEach("names", len(names), func(idx int, is *Is) error {
+			_, err := names[idx].Validate(is)
+			return err
+		})
+		if err != nil {
+			t.Errorf("%d workers: not expecting an error", workers)
+		}
+		if parallel.Len() != sequential.Len() {
+			t.Errorf("%d workers: expected %d errors but got %d", workers, sequential.Len(), parallel.Len())
+		}
+		if !sequential.Errors().IsEqual(parallel.Errors()) {
+			t.Errorf("%d workers: expected the same errors as a sequential run", workers)
+		}
+	}
+}
+
+func TestIs_ParallelEachExistingErrors(t *testing.T) {
+	names := testParallelNames()
+	validateSequentially := func(is *Is) {
+		for idx := range names {
+			is.WithField("names", func(is *Is) {
+				_ = is.ValidStructIndex(idx, names[idx])
+			})
+		}
+	}
+
+	sequential := NewRoot()
+	validateSequentially(sequential)
+	validateSequentially(sequential)
+
+	parallel := NewRoot(Parallelism(4))
+	validateSequentially(parallel)
+	err := parallel.ParallelEach("names", len(names), func(idx int, is *Is) error {
+		_, err := names[idx].Validate(is)
+		return err
+	})
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	if parallel.Len() != sequential.Len() {
+		t.Errorf("expected %d errors but got %d", sequential.Len(), parallel.Len())
+	}
+	if !sequential.Errors().IsEqual(parallel.Errors()) {
+		t.Error("expected the same errors as a sequential run")
+	}
+}
+
+func TestIs_ParallelEachError(t *testing.T) {
+	names := testParallelNames()
+	stop := errors.New("stop")
+
+	is := NewRoot(Parallelism(4))
+	err := is.ParallelEach("names", len(names), func(idx int, is *Is) error {
+		_, _ = names[idx].Validate(is)
+		if idx == 7 || idx == 20 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("expected the stop error but got: %v", err)
+	}
+	numbered := is.Errors().NamedChildren["names"].NumberedChildren
+	for idx := range numbered {
+		if idx > 7 {
+			t.Errorf("expected no errors to be merged after index 7, but got index %d", idx)
+		}
+	}
+	if numbered[7] == nil {
+		t.Error("expected the errors of the failing index to be merged")
+	}
+}
+
+func TestIs_ParallelEachMaxErrors(t *testing.T) {
+	names := testParallelNames()
+	is := NewRoot(MaxErrors(5))
+	err := is.ParallelEach("names", len(names), func(idx int, is *Is) error {
+		_, err := names[idx].Validate(is)
+		return err
+	})
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	if is.Len() != 5 || !is.Truncated() {
+		t.Errorf("expected 5 truncated errors but got %d", is.Len())
+	}
+}
+
+func TestIs_ParallelEachPanic(t *testing.T) {
+	is := NewRoot(Parallelism(4))
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf(`expected the panic to be raised on the calling goroutine but got: %v`, r)
+		}
+		if !is.CurrentPath().IsRoot() {
+			t.Errorf("expected the path to be restored but got %s", is.CurrentPath())
+		}
+	}()
+	_ = is.ParallelEach("names", 50, func(idx int, is *Is) error {
+		if idx == 10 {
+			panic("boom")
+		}
+		return nil
+	})
+	t.Error("expected ParallelEach to panic")
+}
+
+func TestIs_ParallelEachCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	is := NewRoot(WithContext(ctx))
+	err := is.ParallelEach("names", 50, func(idx int, is *Is) error {
+		t.Error("expected no element to be validated once cancelled")
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled but got: %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+	"regexp"
+)
+
+// Rule is a reusable validation of a value of type T. Rules record their
+// errors at the current path of the Is they are checked with, just like the
+// methods of Is, and can be combined with And, Or, Not, When and Each.
+// The rules for the methods of Is share their names, e.g.: IntBetween creates
+// a rule for Is.IntBetween. The rules for the generic asserters are suffixed
+// with Rule instead, e.g.: BetweenRule creates a rule for Between
+// @return true if valid (no errors added) false if not
+//
+// @example
+// ```go
+// var username = issers.And(
+//   issers.StringNotEmpty(nil),
+//   issers.StringLengthBetween(3, 32, nil),
+//   issers.MatchingRegexp(slugRegexp, nil),
+// )
+//
+// is.WithField("username", func(is *issers.Is) {
+//   issers.Check(is, u.Username, username)
+// })
+// ```
+type Rule[T any] func(is *Is, value T) bool
+
+// Check validates the value with the rule at the current path of is
+// @return true if valid (no errors added) false if not
+func (r Rule[T]) Check(is *Is, value T) bool {
+	return r(is, value)
+}
+
+// Check validates the value with the rule at the current path of is. This
+// is a function rather than a method of Is because Go methods cannot have
+// type parameters
+// @return true if valid (no errors added) false if not
+func Check[T any](is *Is, value T, rule Rule[T]) bool {
+	return rule(is, value)
+}
+
+// And creates a rule that is valid if every rule is valid. Rules are
+// checked in order and checking stops at the first rule that is not valid,
+// so later rules may assume that earlier ones passed
+func And[T any](rules ...Rule[T]) Rule[T] {
+	return func(is *Is, value T) bool {
+		for _, rule := range rules {
+			if !rule(is, value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or creates a rule that is valid if at least 1 rule is valid. Rules are
+// checked in order until one is valid. If none are, the errors of every
+// rule are recorded. Otherwise, the errors of the rules that were not
+// valid are discarded. See Is.Try
+func Or[T any](rules ...Rule[T]) Rule[T] {
+	return func(is *Is, value T) bool {
+		passed := false
+		is.Try(func(is *Is) bool {
+			for _, rule := range rules {
+				is.Try(func(is *Is) bool {
+					passed = rule(is, value)
+					return !passed
+				})
+				if passed {
+					break
+				}
+			}
+			return !passed
+		})
+		return passed
+	}
+}
+
+// Not creates a rule that is valid if the rule is NOT valid. The errors of
+// the rule are always discarded
+// @param msg is the callback used to generate the message. Leave nil or return nil
+//   to use the default: ShouldBeFalseErr
+func Not[T any](rule Rule[T], msg func() ifaces.ValidateError) Rule[T] {
+	return func(is *Is, value T) bool {
+		passed := false
+		is.Try(func(is *Is) bool {
+			passed = rule(is, value)
+			return false
+		})
+		return is.False(passed, msg)
+	}
+}
+
+// When creates a rule that only checks the rule if cond returns true for
+// the value. If it returns false, the value is valid
+func When[T any](cond func(value T) bool, rule Rule[T]) Rule[T] {
+	return func(is *Is, value T) bool {
+		if !cond(value) {
+			return true
+		}
+		return rule(is, value)
+	}
+}
+
+// Each creates a rule that checks every element of a slice with the rule.
+// The errors of each element are recorded at its index
+// @return a rule that is valid if every element is valid
+func Each[T any](rule Rule[T]) Rule[[]T] {
+	return func(is *Is, values []T) bool {
+		valid := true
+		for idx, value := range values {
+			is.WithIndex(idx, func(is *Is) {
+				if !rule(is, value) {
+					valid = false
+				}
+			})
+		}
+		return valid
+	}
+}
+
+// Required creates a rule for Is.Required
+func Required() Rule[bool] {
+	return func(is *Is, isPresent bool) bool {
+		return is.Required(isPresent)
+	}
+}
+
+// True creates a rule for Is.True
+func True(msg func() ifaces.ValidateError) Rule[bool] {
+	return func(is *Is, value bool) bool {
+		return is.True(value, msg)
+	}
+}
+
+// False creates a rule for Is.False
+func False(msg func() ifaces.ValidateError) Rule[bool] {
+	return func(is *Is, value bool) bool {
+		return is.False(value, msg)
+	}
+}
+
+// IntBetween creates a rule for Is.IntBetween
+func IntBetween(low, high int, msg func() ifaces.ValidateError) Rule[int] {
+	if low > high {
+		panic("low cannot be greater than high")
+	}
+	return func(is *Is, value int) bool {
+		return is.IntBetween(value, low, high, msg)
+	}
+}
+
+// IntGreaterThan creates a rule for Is.IntGreaterThan
+func IntGreaterThan(low int, msg func() ifaces.ValidateError) Rule[int] {
+	return func(is *Is, value int) bool {
+		return is.IntGreaterThan(value, low, msg)
+	}
+}
+
+// IntLessThan creates a rule for Is.IntLessThan
+func IntLessThan(high int, msg func() ifaces.ValidateError) Rule[int] {
+	return func(is *Is, value int) bool {
+		return is.IntLessThan(value, high, msg)
+	}
+}
+
+// IntGreaterThanOrEqual creates a rule for Is.IntGreaterThanOrEqual
+func IntGreaterThanOrEqual(low int, msg func() ifaces.ValidateError) Rule[int] {
+	return func(is *Is, value int) bool {
+		return is.IntGreaterThanOrEqual(value, low, msg)
+	}
+}
+
+// IntLessThanOrEqual creates a rule for Is.IntLessThanOrEqual
+func IntLessThanOrEqual(high int, msg func() ifaces.ValidateError) Rule[int] {
+	return func(is *Is, value int) bool {
+		return is.IntLessThanOrEqual(value, high, msg)
+	}
+}
+
+// Float64Between creates a rule for Is.Float64Between
+func Float64Between(low, high float64, msg func() ifaces.ValidateError) Rule[float64] {
+	if low > high {
+		panic("low cannot be greater than high")
+	}
+	return func(is *Is, value float64) bool {
+		return is.Float64Between(value, low, high, msg)
+	}
+}
+
+// Float64GreaterThan creates a rule for Is.Float64GreaterThan
+func Float64GreaterThan(low float64, msg func() ifaces.ValidateError) Rule[float64] {
+	return func(is *Is, value float64) bool {
+		return is.Float64GreaterThan(value, low, msg)
+	}
+}
+
+// Float64LessThan creates a rule for Is.Float64LessThan
+func Float64LessThan(high float64, msg func() ifaces.ValidateError) Rule[float64] {
+	return func(is *Is, value float64) bool {
+		return is.Float64LessThan(value, high, msg)
+	}
+}
+
+// Float64GreaterThanOrEqual creates a rule for Is.Float64GreaterThanOrEqual
+func Float64GreaterThanOrEqual(low float64, msg func() ifaces.ValidateError) Rule[float64] {
+	return func(is *Is, value float64) bool {
+		return is.Float64GreaterThanOrEqual(value, low, msg)
+	}
+}
+
+// Float64LessThanOrEqual creates a rule for Is.Float64LessThanOrEqual
+func Float64LessThanOrEqual(high float64, msg func() ifaces.ValidateError) Rule[float64] {
+	return func(is *Is, value float64) bool {
+		return is.Float64LessThanOrEqual(value, high, msg)
+	}
+}
+
+// StringLengthBetween creates a rule for Is.StringLengthBetween
+func StringLengthBetween(low, high int, msg func() ifaces.ValidateError) Rule[string] {
+	if low > high {
+		panic("low cannot be greater than high")
+	}
+	return func(is *Is, value string) bool {
+		return is.StringLengthBetween(value, low, high, msg)
+	}
+}
+
+// StringLengthGreaterThan creates a rule for Is.StringLengthGreaterThan
+func StringLengthGreaterThan(low int, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringLengthGreaterThan(value, low, msg)
+	}
+}
+
+// StringLengthLessThan creates a rule for Is.StringLengthLessThan
+func StringLengthLessThan(high int, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringLengthLessThan(value, high, msg)
+	}
+}
+
+// StringLengthGreaterThanOrEqual creates a rule for Is.StringLengthGreaterThanOrEqual
+func StringLengthGreaterThanOrEqual(low int, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringLengthGreaterThanOrEqual(value, low, msg)
+	}
+}
+
+// StringLengthLessThanOrEqual creates a rule for Is.StringLengthLessThanOrEqual
+func StringLengthLessThanOrEqual(high int, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringLengthLessThanOrEqual(value, high, msg)
+	}
+}
+
+// StringNotEmpty creates a rule for Is.StringNotEmpty
+func StringNotEmpty(msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringNotEmpty(value, msg)
+	}
+}
+
+// StringInStringSlice creates a rule for Is.StringInStringSlice
+func StringInStringSlice(values []string, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.StringInStringSlice(value, values, msg)
+	}
+}
+
+// MatchingRegexp creates a rule for Is.MatchingRegexp
+func MatchingRegexp(reg *regexp.Regexp, msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.MatchingRegexp(value, reg, msg)
+	}
+}
+
+// EmailAddress creates a rule for Is.EmailAddress
+func EmailAddress(msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.EmailAddress(value, msg)
+	}
+}
+
+// URI creates a rule for Is.URI
+func URI(msg func() ifaces.ValidateError) Rule[string] {
+	return func(is *Is, value string) bool {
+		return is.URI(value, msg)
+	}
+}
@@ -0,0 +1,125 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"regexp"
+	"testing"
+)
+
+var testSlugRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+var testUsernameRule = And(
+	StringNotEmpty(nil),
+	StringLengthBetween(3, 32, nil),
+	MatchingRegexp(testSlugRegexp, nil),
+)
+
+func TestAnd(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected *ShouldBeMsg
+	}{
+		"valid": {
+			value: "zoey",
+		},
+		"empty stops at the first rule": {
+			value:    "",
+			expected: NewShouldBeNotEmpty(),
+		},
+		"too short": {
+			value:    "zo",
+			expected: NewShouldBeStringLengthBetween(3, 32),
+		},
+		"not a slug": {
+			value:    "Zoey!",
+			expected: NewShouldMatchingRegexp(),
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot()
+		valid := Check(is, c.value, testUsernameRule)
+		if c.expected == nil {
+			if !valid || is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if valid || is.Len() != 1 {
+			t.Errorf("%s: expected 1 error but got %d", caseName, is.Len())
+			continue
+		}
+		if !is.Errors().IsErrorAt(tree.NewPath(), c.expected) {
+			t.Errorf("%s: expected error %v", caseName, c.expected)
+		}
+	}
+}
+
+func TestOr(t *testing.T) {
+	rule := Or(IntLessThan(0, nil), IntGreaterThan(10, nil))
+
+	is := NewRoot()
+	if !Check(is, 11, rule) || is.HasErrors() {
+		t.Error("expected the errors of the rule that failed to be discarded")
+	}
+
+	is = NewRoot()
+	if Check(is, 5, rule) {
+		t.Error("expected 5 to be invalid")
+	}
+	expected := tree.NewErrorNode(nil)
+	expected.Add(NewShouldBeIntLessThan(0))
+	expected.Add(NewShouldBeIntGreaterThan(10))
+	if !is.Errors().IsEqual(expected) || is.Len() != 2 {
+		t.Errorf("expected the errors of every rule but got %d", is.Len())
+	}
+}
+
+func TestNot(t *testing.T) {
+	rule := Not(StringInStringSlice([]string{"admin", "root"}, nil), nil)
+
+	is := NewRoot()
+	if !Check(is, "zoey", rule) || is.HasErrors() {
+		t.Error("expected the errors of the negated rule to be discarded")
+	}
+
+	is = NewRoot()
+	if Check(is, "root", rule) {
+		t.Error("expected root to be invalid")
+	}
+	if is.Len() != 1 || !is.Errors().IsErrorAt(tree.NewPath(), ShouldBeFalseErr) {
+		t.Error("expected the default message")
+	}
+}
+
+func TestWhen(t *testing.T) {
+	rule := When(func(value string) bool {
+		return len(value) != 0
+	}, EmailAddress(nil))
+
+	is := NewRoot()
+	if !Check(is, "", rule) || is.HasErrors() {
+		t.Error("expected the rule to be skipped")
+	}
+	if Check(is, "zoey", rule) || is.Len() != 1 {
+		t.Error("expected the rule to be checked")
+	}
+}
+
+func TestEach(t *testing.T) {
+	is := NewRoot()
+	is.WithField("tags", func(is *Is) {
+		if Check(is, []string{"go", "", "x"}, Each(testUsernameRule)) {
+			t.Error("expected the tags to be invalid")
+		}
+	})
+
+	expected := tree.NewErrorNode(nil)
+	tags := expected.DownField("tags")
+	tags.DownIndex(0).Add(NewShouldBeStringLengthBetween(3, 32))
+	tags.DownIndex(1).Add(NewShouldBeNotEmpty())
+	tags.DownIndex(2).Add(NewShouldBeStringLengthBetween(3, 32))
+	if !is.Errors().IsEqual(expected) {
+		t.Error("expected the errors of every element at its index")
+	}
+}
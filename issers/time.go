@@ -0,0 +1,89 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+	"time"
+)
+
+// DateISO8601Layout is the ISO 8601 calendar date layout, e.g.: 2006-01-02
+const DateISO8601Layout = "2006-01-02"
+
+// TimeBefore creates an error unless value is before limit
+// @return true if valid (no errors added) false if not
+func (i *Is) TimeBefore(value, limit time.Time, msg func() ifaces.ValidateError) bool {
+	return i.True(value.Before(limit), func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeTimeBefore(limit))
+	})
+}
+
+// TimeAfter creates an error unless value is after limit
+// @return true if valid (no errors added) false if not
+func (i *Is) TimeAfter(value, limit time.Time, msg func() ifaces.ValidateError) bool {
+	return i.True(value.After(limit), func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeTimeAfter(limit))
+	})
+}
+
+// TimeBetween creates an error unless value is between the provided times (inclusive)
+// @return true if valid (no errors added) false if not
+func (i *Is) TimeBetween(value, low, high time.Time, msg func() ifaces.ValidateError) bool {
+	if low.After(high) {
+		panic("low cannot be greater than high")
+	}
+	return i.True(!value.Before(low) && !value.After(high), func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeTimeBetween(low, high))
+	})
+}
+
+// TimeWithin creates an error unless value is at most d before or after the
+// current time. The current time is set with the Clock option
+// @param d is the most value may differ from the current time. It must not be negative
+// @return true if valid (no errors added) false if not
+//
+// @example
+// ```go
+// is.WithField("signed_at", func(is *Is) {
+//   is.TimeWithin(r.SignedAt, 5*time.Minute, nil)
+// })
+// ```
+func (i *Is) TimeWithin(value time.Time, d time.Duration, msg func() ifaces.ValidateError) bool {
+	if d < 0 {
+		panic("d cannot be negative")
+	}
+	diff := value.Sub(i.Now())
+	return i.True(-d <= diff && diff <= d, func() ifaces.ValidateError {
+		return msgOrDefault(msg, NewShouldBeTimeWithin(d))
+	})
+}
+
+// TimeLayout creates an error unless value is a time written as per the layout, see time.Parse
+// @return t the parsed time, the zero time if value could not be parsed
+// @return ok true if valid (no errors added) false if not
+//
+// @example
+// ```go
+// is.WithField("starts_at", func(is *Is) {
+//   if startsAt, ok := is.TimeRFC3339(r.StartsAt, nil); ok {
+//     is.TimeAfter(startsAt, is.Now(), nil)
+//   }
+// })
+// ```
+func (i *Is) TimeLayout(value, layout string, msg func() ifaces.ValidateError) (t time.Time, ok bool) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		i.Invalid(msgOrDefault(msg, NewShouldBeTimeLayout(layout)))
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// TimeRFC3339 is TimeLayout with the RFC 3339 layout, e.g.: 2006-01-02T15:04:05Z07:00.
+// Fractional seconds are accepted
+func (i *Is) TimeRFC3339(value string, msg func() ifaces.ValidateError) (t time.Time, ok bool) {
+	return i.TimeLayout(value, time.RFC3339, msg)
+}
+
+// DateISO8601 is TimeLayout with the ISO 8601 calendar date layout, e.g.: 2006-01-02
+func (i *Is) DateISO8601(value string, msg func() ifaces.ValidateError) (t time.Time, ok bool) {
+	return i.TimeLayout(value, DateISO8601Layout, msg)
+}
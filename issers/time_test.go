@@ -0,0 +1,126 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/tree"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2020, 3, 14, 15, 9, 26, 0, time.UTC)
+
+func TestIs_TimeAsserters(t *testing.T) {
+	earlier := testNow.Add(-time.Hour)
+	later := testNow.Add(time.Hour)
+
+	cases := map[string]struct {
+		validate func(is *Is) bool
+		expected ifaces.ValidateError
+	}{
+		"before": {
+			validate: func(is *Is) bool { return is.TimeBefore(earlier, testNow, nil) },
+		},
+		"not before": {
+			validate: func(is *Is) bool { return is.TimeBefore(testNow, testNow, nil) },
+			expected: NewShouldBeTimeBefore(testNow),
+		},
+		"after": {
+			validate: func(is *Is) bool { return is.TimeAfter(later, testNow, nil) },
+		},
+		"not after": {
+			validate: func(is *Is) bool { return is.TimeAfter(earlier, testNow, nil) },
+			expected: NewShouldBeTimeAfter(testNow),
+		},
+		"between": {
+			validate: func(is *Is) bool { return is.TimeBetween(later, earlier, later, nil) },
+		},
+		"not between": {
+			validate: func(is *Is) bool { return is.TimeBetween(later.Add(time.Second), earlier, later, nil) },
+			expected: NewShouldBeTimeBetween(earlier, later),
+		},
+		"within": {
+			validate: func(is *Is) bool { return is.TimeWithin(earlier, time.Hour, nil) },
+		},
+		"not within": {
+			validate: func(is *Is) bool { return is.TimeWithin(later.Add(time.Second), time.Hour, nil) },
+			expected: NewShouldBeTimeWithin(time.Hour),
+		},
+	}
+
+	for caseName, c := range cases {
+		is := NewRoot(Clock(func() time.Time { return testNow }))
+		valid := c.validate(is)
+		if c.expected == nil {
+			if !valid || is.HasErrors() {
+				t.Errorf("%s: expected no errors", caseName)
+			}
+			continue
+		}
+		if valid || !is.Errors().IsErrorAt(tree.NewPath(), c.expected) {
+			t.Errorf("%s: expected error %v", caseName, c.expected)
+		}
+	}
+}
+
+func TestIs_TimeWithinNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	NewRoot(Clock(func() time.Time { return testNow })).TimeWithin(testNow, -time.Minute, nil)
+}
+
+func TestIs_TimeLayout(t *testing.T) {
+	is := NewRoot()
+	if actual, ok := is.TimeRFC3339("2020-03-14T15:09:26.5Z", nil); !ok || !actual.Equal(testNow.Add(500*time.Millisecond)) {
+		t.Errorf("expected the time to be parsed but got %s", actual)
+	}
+	if actual, ok := is.DateISO8601("2020-03-14", nil); !ok || actual.Day() != 14 {
+		t.Errorf("expected the date to be parsed but got %s", actual)
+	}
+	if is.HasErrors() {
+		t.Error("expected no errors")
+	}
+
+	is.WithField("starts_at", func(is *Is) {
+		if actual, ok := is.TimeRFC3339("2020-03-14", nil); ok || !actual.IsZero() {
+			t.Errorf("expected the time to be invalid but got %s", actual)
+		}
+	})
+	if !is.Errors().IsErrorAt(tree.NewPath().DownField("starts_at"), NewShouldBeTimeLayout(time.RFC3339)) {
+		t.Error("expected the parse error at /starts_at")
+	}
+}
+
+func TestShouldBeTimeMsg_ErrorI18n(t *testing.T) {
+	msg := NewShouldBeTimeBetween(testNow, testNow.Add(time.Hour))
+	expected := "should be between 2020-03-14 15:09:26 UTC and 2020-03-14 16:09:26 UTC"
+	if actual := msg.ErrorI18n(defTestMessagePrinter); actual != expected {
+		t.Errorf(`expected "%s" but got "%s"`, expected, actual)
+	}
+	expected = "Starts at should be between 2020-03-14 15:09:26 UTC and 2020-03-14 16:09:26 UTC"
+	if actual := msg.ErrorI18nLabeled(defTestMessagePrinter, "Starts at"); actual != expected {
+		t.Errorf(`expected "%s" but got "%s"`, expected, actual)
+	}
+	if len(msg.Args) != 2 || msg.Args[0] != testNow {
+		t.Error("expected the arguments to keep the times")
+	}
+}
+
+func TestShouldBeTimeMsg_IsEqual(t *testing.T) {
+	local := testNow.In(time.FixedZone("CET", 60*60))
+	if !NewShouldBeTimeAfter(testNow).IsEqual(NewShouldBeTimeAfter(local)) {
+		t.Error("expected the same instant in another location to be equal")
+	}
+	now := time.Now()
+	if !NewShouldBeTimeBefore(now).IsEqual(NewShouldBeTimeBefore(now.Round(0))) {
+		t.Error("expected the monotonic clock reading to be ignored")
+	}
+	if NewShouldBeTimeAfter(testNow).IsEqual(NewShouldBeTimeAfter(testNow.Add(time.Second))) {
+		t.Error("expected different instants to differ")
+	}
+	if NewShouldBeTimeAfter(testNow).IsEqual(NewShouldBeTimeBefore(testNow)) {
+		t.Error("expected different codes to differ")
+	}
+}
@@ -0,0 +1,46 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+)
+
+// Try performs the validations in wrap tentatively, in a savepoint. The errors
+// and warnings recorded in the savepoint are only added to the receiver if wrap
+// returns true (commit), exactly as if they had been recorded in the receiver
+// directly: equal errors are not de-duplicated like they are by AbsorbAt.
+// If wrap returns false, they are discarded (rollback) and no error nodes are
+// created for them. This is the building block for rules such as "either A or
+// B must be valid"
+// @param wrap is called with an Is at the current path that only holds the
+//   errors recorded in the savepoint, so is.HasErrors tells whether the
+//   validations in wrap passed. Nested calls to Try are supported
+// @return true if no errors were recorded in the savepoint, whether it was
+//   committed or not
+//
+// @example
+// ```go
+// // an id or, failing that, a slug must be provided
+// validID := is.Try(func(is *Is) bool {
+//   is.WithField("id", func(is *Is) {
+//     is.IntGreaterThan(id, 0, nil)
+//   })
+//   return false
+// })
+// if !validID {
+//   is.WithField("slug", func(is *Is) {
+//     is.StringNotEmpty(slug, nil)
+//   })
+// }
+// ```
+func (i *Is) Try(wrap func(is *Is) bool) bool {
+	savepoint := i.fork()
+	savepoint.currentLabel = i.currentLabel
+	if remaining := i.maxErrors - i.errorsCount; i.maxErrors > 0 && remaining > 0 {
+		// The savepoint may only hold as many errors as the receiver has room for
+		savepoint.maxErrors = remaining
+	}
+	if wrap(savepoint) {
+		i.absorbAt(tree.NewPath(), savepoint, false)
+	}
+	return !savepoint.HasErrors()
+}
@@ -0,0 +1,116 @@
+package issers
+
+import (
+	"github.com/wojnosystems/validates/tree"
+	"testing"
+)
+
+func TestIs_TryCommit(t *testing.T) {
+	is := NewRoot()
+	is.WithField("name", func(is *Is) {
+		valid := is.Try(func(is *Is) bool {
+			is.Required(false)
+			is.Warn(NewSimpleValidateError("is deprecated"))
+			if is.Len() != 1 {
+				t.Errorf("expected the savepoint to hold 1 error but got %d", is.Len())
+			}
+			return true
+		})
+		if valid {
+			t.Error("expected Try to report the errors")
+		}
+	})
+
+	if is.Len() != 1 || is.WarningsLen() != 1 {
+		t.Errorf("expected 1 error and 1 warning but got %d and %d", is.Len(), is.WarningsLen())
+	}
+	if !is.Errors().IsErrorAt(tree.NewPath().DownField("name"), ShouldBePresentErr) {
+		t.Error("expected the committed error at /name")
+	}
+}
+
+func TestIs_TryRollback(t *testing.T) {
+	is := NewRoot()
+	is.WithField("id", func(is *Is) {
+		is.Required(false)
+	})
+	valid := is.Try(func(is *Is) bool {
+		is.WithField("slug", func(is *Is) {
+			is.StringNotEmpty("", nil)
+		})
+		return false
+	})
+
+	if valid {
+		t.Error("expected Try to report the errors")
+	}
+	if is.Len() != 1 {
+		t.Errorf("expected the rolled back error to be discarded but got %d errors", is.Len())
+	}
+	if _, ok := is.Errors().NamedChildren["slug"]; ok {
+		t.Error("expected no node to be created for a rolled back error")
+	}
+}
+
+func TestIs_TryNested(t *testing.T) {
+	is := NewRoot()
+	is.Try(func(is *Is) bool {
+		is.WithField("a", func(is *Is) {
+			is.Required(false)
+		})
+		is.Try(func(is *Is) bool {
+			is.WithField("b", func(is *Is) {
+				is.Required(false)
+			})
+			return false
+		})
+		return true
+	})
+
+	expected := tree.NewErrorNode(nil)
+	expected.DownField("a").Add(ShouldBePresentErr)
+	if !is.Errors().IsEqual(expected) {
+		t.Error("expected only the error of the committed savepoint")
+	}
+}
+
+func TestIs_TryMaxErrors(t *testing.T) {
+	is := NewRoot(MaxErrors(2))
+	is.Required(false)
+	is.Try(func(is *Is) bool {
+		is.WithField("a", func(is *Is) {
+			is.Required(false)
+		})
+		is.WithField("b", func(is *Is) {
+			is.Required(false)
+		})
+		return true
+	})
+
+	if is.Len() != 2 {
+		t.Errorf("expected the limit to be honored but got %d errors", is.Len())
+	}
+	if !is.Truncated() {
+		t.Error("expected the results to be truncated")
+	}
+}
+
+func TestIs_TryCommitKeepsEqualErrors(t *testing.T) {
+	direct := NewRoot()
+	direct.Required(false)
+	direct.Required(false)
+
+	is := NewRoot()
+	is.Required(false)
+	is.Try(func(is *Is) bool {
+		is.Required(false)
+		return true
+	})
+
+	if is.Len() != 2 {
+		t.Errorf("expected 2 errors but got %d", is.Len())
+	}
+	if !is.Errors().IsEqual(direct.Errors()) {
+		t.Error("expected the same errors as recording them directly")
+	}
+}
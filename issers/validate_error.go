@@ -1,23 +1,49 @@
 package issers
 
 import (
+	"cmp"
 	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/tree"
 	"golang.org/x/text/message"
 	"net/url"
 	"reflect"
+	"strings"
+	"time"
 )
 
+// SimpleValidateError is an error with a fixed message. Create them with NewSimpleValidateError,
+// the methods have pointer receivers so that the default errors can be told apart from
+// other errors with the same message
 type SimpleValidateError string
 
-func (v SimpleValidateError) ErrorI18n(p *message.Printer) string {
-	return p.Sprint(string(v))
+// ErrorI18n is the error, but internationalized. The default errors, e.g.:
+// ShouldBePresentErr, are looked up using their code as the message ID
+func (v *SimpleValidateError) ErrorI18n(p *message.Printer) string {
+	if code := v.Code(); len(code) != 0 {
+		return p.Sprintf(message.Key(code, string(*v)))
+	}
+	return p.Sprint(string(*v))
+}
+
+// ErrorI18nLabeled is ErrorI18n, but with the translated label as the subject of the message.
+// Implements ifaces.LabeledValidateError
+func (v *SimpleValidateError) ErrorI18nLabeled(p *message.Printer, label string) string {
+	return p.Sprintf(message.Key(LabeledMsgID, DefaultLabeledMsgFmt), translateLabel(p, label), v.ErrorI18n(p))
+}
+
+// Code is the machine-readable identifier of the error if it's one of the default
+// errors: ShouldBeTrueErr, ShouldBeFalseErr or ShouldBePresentErr. It's empty
+// for any other error, even if it has the same message. Implements ifaces.Coder
+func (v *SimpleValidateError) Code() string {
+	return simpleValidateErrorCodes[v]
 }
 
-func (v SimpleValidateError) IsEqual(e ifaces.ValidateError) bool {
+// IsEqual is true if e is a SimpleValidateError with the same message and code
+func (v *SimpleValidateError) IsEqual(e ifaces.ValidateError) bool {
 	if t, ok := e.(*SimpleValidateError); !ok {
 		return false
 	} else {
-		return string(v) == string(*t)
+		return string(*v) == string(*t) && v.Code() == t.Code()
 	}
 }
 
@@ -26,6 +52,67 @@ func NewSimpleValidateError(v string) *SimpleValidateError {
 	return &sve
 }
 
+// Codes are the stable, machine-readable identifiers of the default errors.
+// Unlike the messages, they will not change between releases or locales
+const (
+	CodeTrue     = "true"
+	CodeFalse    = "false"
+	CodeRequired = "required"
+
+	CodeRequiredIf      = "required.if"
+	CodeRequiredUnless  = "required.unless"
+	CodeRequiredWith    = "required.with"
+	CodeRequiredWithout = "required.without"
+
+	CodeExactlyOneOf      = "group.exactly_one"
+	CodeAtLeastOneOf      = "group.at_least_one"
+	CodeMutuallyExclusive = "group.mutually_exclusive"
+	CodeConflict          = "group.conflict"
+
+	CodeIntBetween            = "int.between"
+	CodeIntGreaterThan        = "int.greater_than"
+	CodeIntLessThan           = "int.less_than"
+	CodeIntGreaterThanOrEqual = "int.greater_than_or_equal"
+	CodeIntLessThanOrEqual    = "int.less_than_or_equal"
+
+	CodeFloat64Between            = "float64.between"
+	CodeFloat64GreaterThan        = "float64.greater_than"
+	CodeFloat64LessThan           = "float64.less_than"
+	CodeFloat64GreaterThanOrEqual = "float64.greater_than_or_equal"
+	CodeFloat64LessThanOrEqual    = "float64.less_than_or_equal"
+
+	CodeBetween            = "between"
+	CodeGreaterThan        = "greater_than"
+	CodeLessThan           = "less_than"
+	CodeGreaterThanOrEqual = "greater_than_or_equal"
+	CodeLessThanOrEqual    = "less_than_or_equal"
+
+	CodeEqualToField     = "field.equal"
+	CodeConfirmation     = "field.confirmation"
+	CodeAfterField       = "field.after"
+	CodeBeforeField      = "field.before"
+	CodeGreaterThanField = "field.greater_than"
+	CodeLessThanField    = "field.less_than"
+
+	CodeTimeBefore  = "time.before"
+	CodeTimeAfter   = "time.after"
+	CodeTimeBetween = "time.between"
+	CodeTimeWithin  = "time.within"
+	CodeTimeLayout  = "time.layout"
+
+	CodeStringLengthBetween            = "string.length.between"
+	CodeStringLengthGreaterThan        = "string.length.greater_than"
+	CodeStringLengthLessThan           = "string.length.less_than"
+	CodeStringLengthGreaterThanOrEqual = "string.length.greater_than_or_equal"
+	CodeStringLengthLessThanOrEqual    = "string.length.less_than_or_equal"
+
+	CodeMatchingRegexp = "regexp"
+	CodeEmail          = "email"
+	CodeInStringSlice  = "string.in_slice"
+	CodeNotEmpty       = "string.not_empty"
+	CodeURL            = "url"
+)
+
 var (
 	shouldBeTrueMsg = "should be true"
 	ShouldBeTrueErr = NewSimpleValidateError(shouldBeTrueMsg)
@@ -36,6 +123,19 @@ var (
 	shouldBePresentMsg = "should be present"
 	ShouldBePresentErr = NewSimpleValidateError(shouldBePresentMsg)
 
+	shouldBePresentIfMsg      = "should be present for the current value of %s"
+	shouldBePresentUnlessMsg  = "should be present unless %s allows it to be omitted"
+	shouldBePresentWithMsg    = "should be present when %s is present"
+	shouldBePresentWithoutMsg = "should be present when %s is not present"
+
+	shouldBePresentIfConditionMsg     = "should be present when %s %s"
+	shouldBePresentUnlessConditionMsg = "should be present unless %s %s"
+
+	shouldBeExactlyOneOfMsg      = "exactly one of %s should be present"
+	shouldBeAtLeastOneOfMsg      = "at least one of %s should be present"
+	shouldBeMutuallyExclusiveMsg = "at most one of %s should be present"
+	shouldNotBePresentWithMsg    = "should not be present along with %s"
+
 	shouldBeIntBetweenMsg            = "should be between %d and %d"
 	shouldBeIntGreaterThanMsg        = "should be greater than %d"
 	shouldBeIntLessThanMsg           = "should be less than %d"
@@ -48,6 +148,31 @@ var (
 	shouldBeFloat64GreaterThanOrEqualMsg = "should be greater than or equal to %f"
 	shouldBeFloat64LessThanOrEqualMsg    = "should be less than or equal to %f"
 
+	shouldBeBetweenMsg            = "should be between %v and %v"
+	shouldBeGreaterThanMsg        = "should be greater than %v"
+	shouldBeLessThanMsg           = "should be less than %v"
+	shouldBeGreaterThanOrEqualMsg = "should be greater than or equal to %v"
+	shouldBeLessThanOrEqualMsg    = "should be less than or equal to %v"
+
+	shouldBeEqualToFieldMsg     = "should be equal to %s"
+	shouldBeConfirmationMsg     = "should match %s"
+	shouldBeAfterFieldMsg       = "should be after %s"
+	shouldBeBeforeFieldMsg      = "should be before %s"
+	shouldBeGreaterThanFieldMsg = "should be greater than %s"
+	shouldBeLessThanFieldMsg    = "should be less than %s"
+
+	shouldBeTimeBeforeMsg  = "should be before %s"
+	shouldBeTimeAfterMsg   = "should be after %s"
+	shouldBeTimeBetweenMsg = "should be between %s and %s"
+	shouldBeTimeWithinMsg  = "should be within %v of now"
+	shouldBeTimeLayoutMsg  = "should be a time formatted as %s"
+
+	shouldBeStringLengthBetweenMsg            = "length should be between %d and %d"
+	shouldBeStringLengthGreaterThanMsg        = "length should be greater than %d"
+	shouldBeStringLengthLessThanMsg           = "length should be less than %d"
+	shouldBeStringLengthGreaterThanOrEqualMsg = "length should be greater than or equal to %d"
+	shouldBeStringLengthLessThanOrEqualMsg    = "length should be less than or equal to %d"
+
 	shouldBeMatchingRegexpMsg = "should be formatted properly"
 	shouldBeEmailMsg          = "should be a valid email address"
 
@@ -57,116 +182,592 @@ var (
 	shouldBeURL = "should be URL but was not because %s"
 )
 
+// LabeledMsgID is the message ID of the template used to render a default message
+// with a label. The template receives the translated label and the translated message
+const LabeledMsgID = "labeled"
+
+// Prefixes of the message IDs that labels and conditions are translated with. They keep
+// labels and conditions apart from the codes, so that a label such as "email" isn't
+// rendered as the message of CodeEmail
+const (
+	LabelMsgIDPrefix     = "label."
+	ConditionMsgIDPrefix = "condition."
+)
+
+// LabelMsgID is the message ID that label is translated with, e.g.: "label.First name".
+// Add a translation for it to the catalog to translate the label
+func LabelMsgID(label string) string {
+	return LabelMsgIDPrefix + label
+}
+
+// ConditionMsgID is the message ID that the condition of NewShouldBePresentIfCondition
+// and NewShouldBePresentUnlessCondition is translated with, e.g.: "condition.is in the EU"
+func ConditionMsgID(condition string) string {
+	return ConditionMsgIDPrefix + condition
+}
+
+// DefaultLabeledMsgFmt is the default template used to render a message with a label,
+// e.g.: "First name" + "should be present" becomes "First name should be present"
+const DefaultLabeledMsgFmt = "%s %s"
+
+// TimeFormatMsgID is the message ID of the layout, as per time.Time.Format, used to
+// write the times in the messages. Translate it to write times the way your users do
+const TimeFormatMsgID = "time.format"
+
+// DefaultTimeFormat is the default layout used to write the times in the messages
+const DefaultTimeFormat = "2006-01-02 15:04:05 MST"
+
+// Message IDs of the default messages that share their code with another message.
+// They are used to look up translations in a message catalog, like the codes
+const (
+	// RequiredIfConditionMsgID is the message ID of NewShouldBePresentIfCondition
+	RequiredIfConditionMsgID = "required.if.condition"
+	// RequiredUnlessConditionMsgID is the message ID of NewShouldBePresentUnlessCondition
+	RequiredUnlessConditionMsgID = "required.unless.condition"
+)
+
+// defaultMessages are the default message formats by code. The codes double as
+// the message IDs used to look up translations in a message catalog. Messages
+// that share a code are keyed by their own message ID instead
+var defaultMessages = map[string]string{
+	CodeTrue:     shouldBeTrueMsg,
+	CodeFalse:    shouldBeFalseMsg,
+	CodeRequired: shouldBePresentMsg,
+
+	CodeRequiredIf:      shouldBePresentIfMsg,
+	CodeRequiredUnless:  shouldBePresentUnlessMsg,
+	CodeRequiredWith:    shouldBePresentWithMsg,
+	CodeRequiredWithout: shouldBePresentWithoutMsg,
+
+	RequiredIfConditionMsgID:     shouldBePresentIfConditionMsg,
+	RequiredUnlessConditionMsgID: shouldBePresentUnlessConditionMsg,
+
+	CodeExactlyOneOf:      shouldBeExactlyOneOfMsg,
+	CodeAtLeastOneOf:      shouldBeAtLeastOneOfMsg,
+	CodeMutuallyExclusive: shouldBeMutuallyExclusiveMsg,
+	CodeConflict:          shouldNotBePresentWithMsg,
+
+	CodeIntBetween:            shouldBeIntBetweenMsg,
+	CodeIntGreaterThan:        shouldBeIntGreaterThanMsg,
+	CodeIntLessThan:           shouldBeIntLessThanMsg,
+	CodeIntGreaterThanOrEqual: shouldBeIntGreaterThanOrEqualMsg,
+	CodeIntLessThanOrEqual:    shouldBeIntLessThanOrEqualMsg,
+
+	CodeFloat64Between:            shouldBeFloat64BetweenMsg,
+	CodeFloat64GreaterThan:        shouldBeFloat64GreaterThanMsg,
+	CodeFloat64LessThan:           shouldBeFloat64LessThanMsg,
+	CodeFloat64GreaterThanOrEqual: shouldBeFloat64GreaterThanOrEqualMsg,
+	CodeFloat64LessThanOrEqual:    shouldBeFloat64LessThanOrEqualMsg,
+
+	CodeBetween:            shouldBeBetweenMsg,
+	CodeGreaterThan:        shouldBeGreaterThanMsg,
+	CodeLessThan:           shouldBeLessThanMsg,
+	CodeGreaterThanOrEqual: shouldBeGreaterThanOrEqualMsg,
+	CodeLessThanOrEqual:    shouldBeLessThanOrEqualMsg,
+
+	CodeEqualToField:     shouldBeEqualToFieldMsg,
+	CodeConfirmation:     shouldBeConfirmationMsg,
+	CodeAfterField:       shouldBeAfterFieldMsg,
+	CodeBeforeField:      shouldBeBeforeFieldMsg,
+	CodeGreaterThanField: shouldBeGreaterThanFieldMsg,
+	CodeLessThanField:    shouldBeLessThanFieldMsg,
+
+	CodeTimeBefore:  shouldBeTimeBeforeMsg,
+	CodeTimeAfter:   shouldBeTimeAfterMsg,
+	CodeTimeBetween: shouldBeTimeBetweenMsg,
+	CodeTimeWithin:  shouldBeTimeWithinMsg,
+	CodeTimeLayout:  shouldBeTimeLayoutMsg,
+
+	CodeStringLengthBetween:            shouldBeStringLengthBetweenMsg,
+	CodeStringLengthGreaterThan:        shouldBeStringLengthGreaterThanMsg,
+	CodeStringLengthLessThan:           shouldBeStringLengthLessThanMsg,
+	CodeStringLengthGreaterThanOrEqual: shouldBeStringLengthGreaterThanOrEqualMsg,
+	CodeStringLengthLessThanOrEqual:    shouldBeStringLengthLessThanOrEqualMsg,
+
+	CodeMatchingRegexp: shouldBeMatchingRegexpMsg,
+	CodeEmail:          shouldBeEmailMsg,
+	CodeInStringSlice:  shouldBeInStringSlice,
+	CodeNotEmpty:       shouldBeNotEmpty,
+	CodeURL:            shouldBeURL,
+}
+
+// simpleValidateErrorCodes are the codes of the default errors that are SimpleValidateErrors,
+// keyed by the errors themselves so that other errors with the same message have no code
+var simpleValidateErrorCodes = map[*SimpleValidateError]string{
+	ShouldBeTrueErr:    CodeTrue,
+	ShouldBeFalseErr:   CodeFalse,
+	ShouldBePresentErr: CodeRequired,
+}
+
+// DefaultMessages returns the default message format for every code, and for the message
+// IDs of the messages that share a code, e.g.: RequiredIfConditionMsgID. Message catalogs
+// should use the keys as message IDs to translate the default messages
+// @return a copy, changing it has no effect
+func DefaultMessages() map[string]string {
+	out := make(map[string]string, len(defaultMessages))
+	for code, msgFmt := range defaultMessages {
+		out[code] = msgFmt
+	}
+	return out
+}
+
 type ShouldBeMsg struct {
 	ifaces.ValidateError
 	MsgFmt string
 	Args   []interface{}
+	// ErrorCode is the machine-readable identifier of the error, e.g.: CodeIntBetween
+	ErrorCode string
+	// LabeledMsgFmt is the message format used when the field has a label. The
+	// translated label is the first argument, followed by Args, e.g.:
+	// "%s must be between %d and %d characters". If empty, the message is
+	// combined with the label using the LabeledMsgID template
+	LabeledMsgFmt string
+}
+
+// newShouldBeMsg creates a ShouldBeMsg with the code and message format
+func newShouldBeMsg(code, msgFmt string, args ...interface{}) *ShouldBeMsg {
+	if args == nil {
+		args = []interface{}{}
+	}
+	return &ShouldBeMsg{
+		MsgFmt:    msgFmt,
+		Args:      args,
+		ErrorCode: code,
+	}
 }
 
 // ErrorI18n is the error, but internationalized
 // I know English so my errors are all in English
+// If MsgFmt is the default message for ErrorCode, the translation is looked up
+// using the code as the message ID, falling back to MsgFmt if there is none.
+// Customized messages are looked up using MsgFmt itself
 func (v ShouldBeMsg) ErrorI18n(p *message.Printer) string {
+	if len(v.ErrorCode) != 0 && defaultMessages[v.ErrorCode] == v.MsgFmt {
+		return p.Sprintf(message.Key(v.ErrorCode, v.MsgFmt), v.Args...)
+	}
 	return p.Sprintf(v.MsgFmt, v.Args...)
 }
 
+// ErrorI18nLabeled is ErrorI18n, but with the translated label as the subject of the message.
+// Implements ifaces.LabeledValidateError
+func (v ShouldBeMsg) ErrorI18nLabeled(p *message.Printer, label string) string {
+	translatedLabel := translateLabel(p, label)
+	if len(v.LabeledMsgFmt) != 0 {
+		return p.Sprintf(v.LabeledMsgFmt, append([]interface{}{translatedLabel}, v.Args...)...)
+	}
+	return p.Sprintf(message.Key(LabeledMsgID, DefaultLabeledMsgFmt), translatedLabel, v.ErrorI18n(p))
+}
+
+// translateLabel returns the translation of the label, looked up with LabelMsgID
+func translateLabel(p *message.Printer, label string) string {
+	return translateText(p, LabelMsgID(label), label)
+}
+
+// translateText returns the translation of text found for the message ID.
+// Texts without a translation are returned as-is, even if they hold a "%".
+// Translations are formats, so a "%" in a translation must be written as "%%"
+func translateText(p *message.Printer, msgID, text string) string {
+	return p.Sprintf(message.Key(msgID, "%s"), text)
+}
+
+// Code is the machine-readable identifier of the error. Implements ifaces.Coder
+func (v ShouldBeMsg) Code() string {
+	return v.ErrorCode
+}
+
 func (v ShouldBeMsg) IsEqual(e ifaces.ValidateError) bool {
 	if t, ok := e.(*ShouldBeMsg); !ok {
 		return false
 	} else {
 		return v.MsgFmt == t.MsgFmt &&
+			v.ErrorCode == t.ErrorCode &&
+			v.LabeledMsgFmt == t.LabeledMsgFmt &&
 			reflect.DeepEqual(v.Args, t.Args)
 	}
 }
 
-func NewShouldBeIntBetween(low, high int) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeIntBetweenMsg,
-		Args:   []interface{}{low, high},
+// ShouldBeFieldMsg is a ShouldBeMsg about how the value compares with another field.
+// The only argument of the default messages is the path to the other field, written
+// as per tree.PathStyleDotted, e.g.: "should match password"
+type ShouldBeFieldMsg struct {
+	ShouldBeMsg
+	// Field is the path, from the root, to the field the value was compared with
+	Field tree.Path
+}
+
+// newShouldBeFieldMsg creates a ShouldBeFieldMsg with the code and message format
+func newShouldBeFieldMsg(code, msgFmt string, field tree.Path) *ShouldBeFieldMsg {
+	return &ShouldBeFieldMsg{
+		ShouldBeMsg: *newShouldBeMsg(code, msgFmt, field.Format(tree.PathStyleDotted)),
+		Field:       field,
 	}
 }
 
-func NewShouldBeIntGreaterThan(low int) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeIntGreaterThanMsg,
-		Args:   []interface{}{low},
+// References returns the path to the field the value was compared with. Implements tree.Referencer
+func (v ShouldBeFieldMsg) References() []tree.Path {
+	return []tree.Path{v.Field}
+}
+
+func (v ShouldBeFieldMsg) IsEqual(e ifaces.ValidateError) bool {
+	if t, ok := e.(*ShouldBeFieldMsg); !ok {
+		return false
+	} else {
+		return v.ShouldBeMsg.IsEqual(&t.ShouldBeMsg) && v.Field.IsEqual(t.Field)
 	}
 }
-func NewShouldBeIntLessThan(high int) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeIntLessThanMsg,
-		Args:   []interface{}{high},
+
+func NewShouldBeEqualToField(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeEqualToField, shouldBeEqualToFieldMsg, field)
+}
+
+func NewShouldBeConfirmationOf(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeConfirmation, shouldBeConfirmationMsg, field)
+}
+
+func NewShouldBeAfterField(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeAfterField, shouldBeAfterFieldMsg, field)
+}
+
+func NewShouldBeBeforeField(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeBeforeField, shouldBeBeforeFieldMsg, field)
+}
+
+func NewShouldBeGreaterThanField(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeGreaterThanField, shouldBeGreaterThanFieldMsg, field)
+}
+
+func NewShouldBeLessThanField(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeLessThanField, shouldBeLessThanFieldMsg, field)
+}
+
+// ShouldBeGroupMsg is a ShouldBeMsg about a group of fields, e.g.: "exactly one of id, slug should be present".
+// The only argument of the default messages is the list of paths to the fields, written as per
+// tree.PathStyleDotted and separated by commas
+type ShouldBeGroupMsg struct {
+	ShouldBeMsg
+	// Fields are the paths, from the root, to the fields of the group
+	Fields []tree.Path
+}
+
+// newShouldBeGroupMsg creates a ShouldBeGroupMsg with the code and message format
+func newShouldBeGroupMsg(code, msgFmt string, fields []tree.Path) *ShouldBeGroupMsg {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Format(tree.PathStyleDotted))
+	}
+	return &ShouldBeGroupMsg{
+		ShouldBeMsg: *newShouldBeMsg(code, msgFmt, strings.Join(names, ", ")),
+		Fields:      fields,
 	}
 }
 
-func NewShouldBeIntGreaterThanOrEqual(low int) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeIntGreaterThanOrEqualMsg,
-		Args:   []interface{}{low},
+// References returns the paths to the fields of the group. Implements tree.Referencer
+func (v ShouldBeGroupMsg) References() []tree.Path {
+	return v.Fields
+}
+
+func (v ShouldBeGroupMsg) IsEqual(e ifaces.ValidateError) bool {
+	if t, ok := e.(*ShouldBeGroupMsg); !ok {
+		return false
+	} else {
+		if !v.ShouldBeMsg.IsEqual(&t.ShouldBeMsg) || len(v.Fields) != len(t.Fields) {
+			return false
+		}
+		for i := range v.Fields {
+			if !v.Fields[i].IsEqual(t.Fields[i]) {
+				return false
+			}
+		}
+		return true
 	}
 }
 
-func NewShouldBeIntLessThanOrEqual(high int) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeIntLessThanOrEqualMsg,
-		Args:   []interface{}{high},
+func NewShouldBeExactlyOneOf(fields []tree.Path) *ShouldBeGroupMsg {
+	return newShouldBeGroupMsg(CodeExactlyOneOf, shouldBeExactlyOneOfMsg, fields)
+}
+
+func NewShouldBeAtLeastOneOf(fields []tree.Path) *ShouldBeGroupMsg {
+	return newShouldBeGroupMsg(CodeAtLeastOneOf, shouldBeAtLeastOneOfMsg, fields)
+}
+
+func NewShouldBeMutuallyExclusive(fields []tree.Path) *ShouldBeGroupMsg {
+	return newShouldBeGroupMsg(CodeMutuallyExclusive, shouldBeMutuallyExclusiveMsg, fields)
+}
+
+func NewShouldNotBePresentWith(fields []tree.Path) *ShouldBeGroupMsg {
+	return newShouldBeGroupMsg(CodeConflict, shouldNotBePresentWithMsg, fields)
+}
+
+func NewShouldBePresentIf(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeRequiredIf, shouldBePresentIfMsg, field)
+}
+
+func NewShouldBePresentUnless(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeRequiredUnless, shouldBePresentUnlessMsg, field)
+}
+
+// NewShouldBePresentIfCondition is NewShouldBePresentIf, but the message also describes the condition
+// @param condition is what makes the value required, written to follow the field name, e.g.:
+//   "is in the EU" for "should be present when country is in the EU". It's translated
+//   by the printer, using the message ID returned by ConditionMsgID
+func NewShouldBePresentIfCondition(field tree.Path, condition string) *ShouldBeConditionMsg {
+	return newShouldBeConditionMsg(CodeRequiredIf, RequiredIfConditionMsgID, field, condition)
+}
+
+// NewShouldBePresentUnlessCondition is NewShouldBePresentUnless, but the message also describes the condition
+// @param condition is what makes the value optional, written to follow the field name, e.g.:
+//   "is checked" for "should be present unless same_as_billing is checked". See NewShouldBePresentIfCondition
+func NewShouldBePresentUnlessCondition(field tree.Path, condition string) *ShouldBeConditionMsg {
+	return newShouldBeConditionMsg(CodeRequiredUnless, RequiredUnlessConditionMsgID, field, condition)
+}
+
+func NewShouldBePresentWith(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeRequiredWith, shouldBePresentWithMsg, field)
+}
+
+func NewShouldBePresentWithout(field tree.Path) *ShouldBeFieldMsg {
+	return newShouldBeFieldMsg(CodeRequiredWithout, shouldBePresentWithoutMsg, field)
+}
+
+// ShouldBeConditionMsg is a ShouldBeFieldMsg that also describes the condition that makes the
+// value required. The translated Condition is passed to the message after the path to the field
+type ShouldBeConditionMsg struct {
+	ShouldBeFieldMsg
+	// Condition describes the condition, e.g.: "is in the EU". It's translated by the printer, see ConditionMsgID
+	Condition string
+	// msgID is the message ID the default message is translated with, as the code is shared
+	msgID string
+}
+
+// newShouldBeConditionMsg creates a ShouldBeConditionMsg with the code and the message ID of a default message
+func newShouldBeConditionMsg(code, msgID string, field tree.Path, condition string) *ShouldBeConditionMsg {
+	return &ShouldBeConditionMsg{
+		ShouldBeFieldMsg: *newShouldBeFieldMsg(code, defaultMessages[msgID], field),
+		Condition:        condition,
+		msgID:            msgID,
 	}
 }
 
-func NewShouldBeFloat64Between(low, high float64) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeFloat64BetweenMsg,
-		Args:   []interface{}{low, high},
+// localized returns a copy of the message with the translated condition added to Args. The copy
+// uses the message ID as its code, so that ShouldBeMsg.ErrorI18n looks up the right translation
+func (v ShouldBeConditionMsg) localized(p *message.Printer) ShouldBeMsg {
+	msg := v.ShouldBeMsg
+	msg.Args = append(append([]interface{}{}, v.Args...), translateText(p, ConditionMsgID(v.Condition), v.Condition))
+	msg.ErrorCode = v.msgID
+	return msg
+}
+
+// ErrorI18n is ShouldBeMsg.ErrorI18n, with the translated condition
+func (v ShouldBeConditionMsg) ErrorI18n(p *message.Printer) string {
+	return v.localized(p).ErrorI18n(p)
+}
+
+// ErrorI18nLabeled is ShouldBeMsg.ErrorI18nLabeled, with the translated condition
+func (v ShouldBeConditionMsg) ErrorI18nLabeled(p *message.Printer, label string) string {
+	return v.localized(p).ErrorI18nLabeled(p, label)
+}
+
+func (v ShouldBeConditionMsg) IsEqual(e ifaces.ValidateError) bool {
+	if t, ok := e.(*ShouldBeConditionMsg); !ok {
+		return false
+	} else {
+		return v.ShouldBeFieldMsg.IsEqual(&t.ShouldBeFieldMsg) && v.Condition == t.Condition
 	}
 }
 
-func NewShouldBeFloat64GreaterThan(low float64) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeFloat64GreaterThanMsg,
-		Args:   []interface{}{low},
+// ShouldBeTimeMsg is a ShouldBeMsg whose Args may hold time.Time values. The times are
+// written using the layout that the printer finds for TimeFormatMsgID, so they are
+// written the way the user is used to
+type ShouldBeTimeMsg struct {
+	ShouldBeMsg
+}
+
+// newShouldBeTimeMsg creates a ShouldBeTimeMsg with the code and message format
+func newShouldBeTimeMsg(code, msgFmt string, args ...interface{}) *ShouldBeTimeMsg {
+	return &ShouldBeTimeMsg{
+		ShouldBeMsg: *newShouldBeMsg(code, msgFmt, args...),
 	}
 }
-func NewShouldBeFloat64LessThan(high float64) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeFloat64LessThanMsg,
-		Args:   []interface{}{high},
+
+// localized returns a copy of the message with the times in Args written for the printer
+func (v ShouldBeTimeMsg) localized(p *message.Printer) ShouldBeMsg {
+	layout := p.Sprintf(message.Key(TimeFormatMsgID, DefaultTimeFormat))
+	msg := v.ShouldBeMsg
+	msg.Args = make([]interface{}, len(v.Args))
+	for i, arg := range v.Args {
+		if t, ok := arg.(time.Time); ok {
+			msg.Args[i] = t.Format(layout)
+		} else {
+			msg.Args[i] = arg
+		}
 	}
+	return msg
 }
 
-func NewShouldBeFloat64GreaterThanOrEqual(low float64) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeFloat64GreaterThanOrEqualMsg,
-		Args:   []interface{}{low},
+// ErrorI18n is ShouldBeMsg.ErrorI18n, with the times written for the printer
+func (v ShouldBeTimeMsg) ErrorI18n(p *message.Printer) string {
+	return v.localized(p).ErrorI18n(p)
+}
+
+// ErrorI18nLabeled is ShouldBeMsg.ErrorI18nLabeled, with the times written for the printer
+func (v ShouldBeTimeMsg) ErrorI18nLabeled(p *message.Printer, label string) string {
+	return v.localized(p).ErrorI18nLabeled(p, label)
+}
+
+// IsEqual is ShouldBeMsg.IsEqual, but times in Args are compared with time.Time.Equal,
+// so the same instant is equal whatever its location or monotonic clock reading
+func (v ShouldBeTimeMsg) IsEqual(e ifaces.ValidateError) bool {
+	if t, ok := e.(*ShouldBeTimeMsg); !ok {
+		return false
+	} else {
+		return v.MsgFmt == t.MsgFmt &&
+			v.ErrorCode == t.ErrorCode &&
+			v.LabeledMsgFmt == t.LabeledMsgFmt &&
+			sameTimeArgs(v.Args, t.Args)
 	}
 }
 
-func NewShouldBeFloat64LessThanOrEqual(high float64) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeFloat64LessThanOrEqualMsg,
-		Args:   []interface{}{high},
+// sameTimeArgs is reflect.DeepEqual, but the time.Time arguments are compared with time.Time.Equal
+func sameTimeArgs(aa, bb []interface{}) bool {
+	if len(aa) != len(bb) {
+		return false
 	}
+	for i := range aa {
+		at, aIsTime := aa[i].(time.Time)
+		bt, bIsTime := bb[i].(time.Time)
+		if aIsTime && bIsTime {
+			if !at.Equal(bt) {
+				return false
+			}
+		} else if !reflect.DeepEqual(aa[i], bb[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func NewShouldBeTimeBefore(limit time.Time) *ShouldBeTimeMsg {
+	return newShouldBeTimeMsg(CodeTimeBefore, shouldBeTimeBeforeMsg, limit)
+}
+
+func NewShouldBeTimeAfter(limit time.Time) *ShouldBeTimeMsg {
+	return newShouldBeTimeMsg(CodeTimeAfter, shouldBeTimeAfterMsg, limit)
+}
+
+func NewShouldBeTimeBetween(low, high time.Time) *ShouldBeTimeMsg {
+	return newShouldBeTimeMsg(CodeTimeBetween, shouldBeTimeBetweenMsg, low, high)
+}
+
+func NewShouldBeTimeWithin(d time.Duration) *ShouldBeTimeMsg {
+	return newShouldBeTimeMsg(CodeTimeWithin, shouldBeTimeWithinMsg, d)
+}
+
+func NewShouldBeTimeLayout(layout string) *ShouldBeTimeMsg {
+	return newShouldBeTimeMsg(CodeTimeLayout, shouldBeTimeLayoutMsg, layout)
+}
+
+func NewShouldBeIntBetween(low, high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeIntBetween, shouldBeIntBetweenMsg, low, high)
+}
+
+func NewShouldBeIntGreaterThan(low int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeIntGreaterThan, shouldBeIntGreaterThanMsg, low)
+}
+
+func NewShouldBeIntLessThan(high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeIntLessThan, shouldBeIntLessThanMsg, high)
+}
+
+func NewShouldBeIntGreaterThanOrEqual(low int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeIntGreaterThanOrEqual, shouldBeIntGreaterThanOrEqualMsg, low)
+}
+
+func NewShouldBeIntLessThanOrEqual(high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeIntLessThanOrEqual, shouldBeIntLessThanOrEqualMsg, high)
+}
+
+func NewShouldBeFloat64Between(low, high float64) *ShouldBeMsg {
+	return newShouldBeMsg(CodeFloat64Between, shouldBeFloat64BetweenMsg, low, high)
+}
+
+func NewShouldBeFloat64GreaterThan(low float64) *ShouldBeMsg {
+	return newShouldBeMsg(CodeFloat64GreaterThan, shouldBeFloat64GreaterThanMsg, low)
+}
+
+func NewShouldBeFloat64LessThan(high float64) *ShouldBeMsg {
+	return newShouldBeMsg(CodeFloat64LessThan, shouldBeFloat64LessThanMsg, high)
+}
+
+func NewShouldBeFloat64GreaterThanOrEqual(low float64) *ShouldBeMsg {
+	return newShouldBeMsg(CodeFloat64GreaterThanOrEqual, shouldBeFloat64GreaterThanOrEqualMsg, low)
+}
+
+func NewShouldBeFloat64LessThanOrEqual(high float64) *ShouldBeMsg {
+	return newShouldBeMsg(CodeFloat64LessThanOrEqual, shouldBeFloat64LessThanOrEqualMsg, high)
+}
+
+// NewShouldBeBetween creates the default error for Between. The bounds keep
+// their type so that they are formatted properly, e.g.: time.Duration as "1m30s"
+func NewShouldBeBetween[T cmp.Ordered](low, high T) *ShouldBeMsg {
+	return newShouldBeMsg(CodeBetween, shouldBeBetweenMsg, low, high)
+}
+
+// NewShouldBeGreaterThan creates the default error for GreaterThan
+func NewShouldBeGreaterThan[T cmp.Ordered](low T) *ShouldBeMsg {
+	return newShouldBeMsg(CodeGreaterThan, shouldBeGreaterThanMsg, low)
+}
+
+// NewShouldBeLessThan creates the default error for LessThan
+func NewShouldBeLessThan[T cmp.Ordered](high T) *ShouldBeMsg {
+	return newShouldBeMsg(CodeLessThan, shouldBeLessThanMsg, high)
+}
+
+// NewShouldBeGreaterThanOrEqual creates the default error for GreaterThanOrEqual
+func NewShouldBeGreaterThanOrEqual[T cmp.Ordered](low T) *ShouldBeMsg {
+	return newShouldBeMsg(CodeGreaterThanOrEqual, shouldBeGreaterThanOrEqualMsg, low)
+}
+
+// NewShouldBeLessThanOrEqual creates the default error for LessThanOrEqual
+func NewShouldBeLessThanOrEqual[T cmp.Ordered](high T) *ShouldBeMsg {
+	return newShouldBeMsg(CodeLessThanOrEqual, shouldBeLessThanOrEqualMsg, high)
+}
+
+func NewShouldBeStringLengthBetween(low, high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeStringLengthBetween, shouldBeStringLengthBetweenMsg, low, high)
+}
+
+func NewShouldBeStringLengthGreaterThan(low int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeStringLengthGreaterThan, shouldBeStringLengthGreaterThanMsg, low)
+}
+
+func NewShouldBeStringLengthLessThan(high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeStringLengthLessThan, shouldBeStringLengthLessThanMsg, high)
+}
+
+func NewShouldBeStringLengthGreaterThanOrEqual(low int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeStringLengthGreaterThanOrEqual, shouldBeStringLengthGreaterThanOrEqualMsg, low)
+}
+
+func NewShouldBeStringLengthLessThanOrEqual(high int) *ShouldBeMsg {
+	return newShouldBeMsg(CodeStringLengthLessThanOrEqual, shouldBeStringLengthLessThanOrEqualMsg, high)
 }
 
 func NewShouldMatchingRegexp() *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeMatchingRegexpMsg,
-		Args:   []interface{}{},
-	}
+	return newShouldBeMsg(CodeMatchingRegexp, shouldBeMatchingRegexpMsg)
 }
+
+func NewShouldBeEmail() *ShouldBeMsg {
+	return newShouldBeMsg(CodeEmail, shouldBeEmailMsg)
+}
+
 func NewShouldBeInStringSlice() *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeInStringSlice,
-		Args:   []interface{}{},
-	}
+	return newShouldBeMsg(CodeInStringSlice, shouldBeInStringSlice)
 }
+
 func NewShouldBeURL(err *url.Error) *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeURL,
-		Args:   []interface{}{err.Err.Error()},
-	}
+	return newShouldBeMsg(CodeURL, shouldBeURL, err.Err.Error())
 }
+
 func NewShouldBeNotEmpty() *ShouldBeMsg {
-	return &ShouldBeMsg{
-		MsgFmt: shouldBeNotEmpty,
-		Args:   []interface{}{},
-	}
+	return newShouldBeMsg(CodeNotEmpty, shouldBeNotEmpty)
 }
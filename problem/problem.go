@@ -0,0 +1,123 @@
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wojnosystems/validates/ifaces"
+	"github.com/wojnosystems/validates/issers"
+	"github.com/wojnosystems/validates/tree"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// The problem package renders validation results as a Problem Details
+// document, as described by RFC 7807 and its successor RFC 9457. Each
+// validation error is listed in the "errors" extension member along
+// with a JSON Pointer (RFC 6901) to the offending input.
+
+// ContentType is the media type of a Problem Details document
+const ContentType = "application/problem+json"
+
+var (
+	// DefaultType is the problem type used when none is set. "about:blank"
+	// means the problem has no semantics beyond the HTTP status code
+	DefaultType = "about:blank"
+
+	// DefaultTitle is the summary of the problem. It is localized with the
+	// message.Printer provided to New
+	DefaultTitle = "Your request parameters didn't validate"
+)
+
+// Problem is a Problem Details document with the "errors" extension member
+type Problem struct {
+	Type     string  `json:"type,omitempty"`
+	Title    string  `json:"title,omitempty"`
+	Status   int     `json:"status,omitempty"`
+	Detail   string  `json:"detail,omitempty"`
+	Instance string  `json:"instance,omitempty"`
+	Errors   []Error `json:"errors"`
+
+	// Warnings are the advisory findings, they are omitted if there are none
+	Warnings []Error `json:"warnings,omitempty"`
+}
+
+// Error is a single validation error or warning within a Problem.
+// Because of References, Errors cannot be compared with ==, use reflect.DeepEqual instead
+type Error struct {
+	// Pointer is the RFC 6901 JSON Pointer to the input that was invalid
+	Pointer string `json:"pointer"`
+
+	// Detail is the localized error message
+	Detail string `json:"detail"`
+
+	// Code is the machine-readable error code, if the error implements ifaces.Coder
+	Code string `json:"code,omitempty"`
+
+	// References are JSON Pointers to the other fields the error is about,
+	// if the error implements tree.Referencer, e.g.: "/password"
+	References []string `json:"references,omitempty"`
+}
+
+// New creates a Problem from the errors recorded in is. The status is set to
+// http.StatusUnprocessableEntity. Errors are listed in the order that
+// tree.ErrorNode.Walk visits them. Warnings are listed in the Warnings member
+// @param is the validation result to render
+// @param p is used to localize the title and every error. If nil, American English is used
+// @return the problem document, with an empty Errors list if is has no errors
+func New(is *issers.Is, p *message.Printer) *Problem {
+	if p == nil {
+		p = message.NewPrinter(language.AmericanEnglish)
+	}
+	pr := &Problem{
+		Type:   DefaultType,
+		Title:  p.Sprint(DefaultTitle),
+		Status: http.StatusUnprocessableEntity,
+		Errors: make([]Error, 0, is.Len()),
+	}
+	_ = is.Errors().WalkNodes(func(path tree.Path, node *tree.ErrorNode) error {
+		for _, e := range node.Errors() {
+			pr.Errors = append(pr.Errors, newError(path, e, node.Label(), p))
+		}
+		return nil
+	})
+	_ = is.Errors().WalkWarnings(func(path tree.Path, warnings []ifaces.ValidateError) error {
+		label := is.Errors().LabelAt(path)
+		for _, e := range warnings {
+			pr.Warnings = append(pr.Warnings, newError(path, e, label, p))
+		}
+		return nil
+	})
+	return pr
+}
+
+// Render writes the problem to w with the problem+json content type and the problem's status code
+// @return err if the problem could not be encoded
+func (pr *Problem) Render(w http.ResponseWriter) error {
+	body, err := json.Marshal(pr)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(pr.Status)
+	_, err = w.Write(body)
+	return err
+}
+
+// newError creates the Error for a single ValidateError
+// @param label of the node holding e, may be empty
+func newError(path tree.Path, e ifaces.ValidateError, label string, p *message.Printer) Error {
+	pe := Error{
+		Pointer: path.JSONPointer(),
+		Detail:  tree.Localize(e, p, label),
+	}
+	if c, ok := e.(ifaces.Coder); ok {
+		pe.Code = c.Code()
+	}
+	if r, ok := e.(tree.Referencer); ok {
+		for _, ref := range r.References() {
+			pe.References = append(pe.References, ref.JSONPointer())
+		}
+	}
+	return pe
+}
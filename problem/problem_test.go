@@ -0,0 +1,144 @@
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/wojnosystems/validates/issers"
+	"github.com/wojnosystems/validates/tree"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+var defTestMessagePrinter = message.NewPrinter(language.AmericanEnglish)
+
+func newTestIs() *issers.Is {
+	is := issers.NewRoot()
+	is.WithField("name", func(is *issers.Is) {
+		is.WithField("first", func(is *issers.Is) {
+			is.Required(false)
+		})
+	})
+	is.WithField("emails", func(is *issers.Is) {
+		is.WithIndex(1, func(is *issers.Is) {
+			is.EmailAddress("nope", nil)
+		})
+		is.WithIndex(0, func(is *issers.Is) {
+			is.EmailAddress("nah", nil)
+		})
+	})
+	is.WithField("age", func(is *issers.Is) {
+		is.IntGreaterThanOrEqual(15, 18, nil)
+	})
+	return is
+}
+
+func TestNew(t *testing.T) {
+	pr := New(newTestIs(), defTestMessagePrinter)
+	if pr.Status != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d but got %d", http.StatusUnprocessableEntity, pr.Status)
+	}
+	expected := []Error{
+		{Pointer: "/age", Detail: "should be greater than or equal to 18", Code: issers.CodeIntGreaterThanOrEqual},
+		{Pointer: "/emails/0", Detail: "should be a valid email address", Code: issers.CodeEmail},
+		{Pointer: "/emails/1", Detail: "should be a valid email address", Code: issers.CodeEmail},
+		{Pointer: "/name/first", Detail: "should be present", Code: issers.CodeRequired},
+	}
+	if len(pr.Errors) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %v", len(expected), len(pr.Errors), pr.Errors)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(pr.Errors[i], expected[i]) {
+			t.Errorf("error %d: expected %v but got %v", i, expected[i], pr.Errors[i])
+		}
+	}
+}
+
+func TestNew_NilPrinter(t *testing.T) {
+	pr := New(newTestIs(), nil)
+	if pr.Title != DefaultTitle {
+		t.Errorf(`expected the title "%s" but got "%s"`, DefaultTitle, pr.Title)
+	}
+	if len(pr.Errors) == 0 || pr.Errors[0].Detail != "should be greater than or equal to 18" {
+		t.Errorf("expected the errors in American English but got %v", pr.Errors)
+	}
+}
+
+func TestNew_NoErrors(t *testing.T) {
+	pr := New(issers.NewRoot(), defTestMessagePrinter)
+	body, err := json.Marshal(pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"type":"about:blank","title":"Your request parameters didn't validate","status":422,"errors":[]}`
+	if string(body) != expected {
+		t.Errorf("expected: %s but got: %s", expected, string(body))
+	}
+}
+
+func TestNew_Labeled(t *testing.T) {
+	is := issers.NewRoot()
+	is.WithLabeledField("firstName", "First name", func(is *issers.Is) {
+		is.Required(false)
+	})
+	pr := New(is, defTestMessagePrinter)
+	if len(pr.Errors) != 1 || pr.Errors[0].Detail != "First name should be present" {
+		t.Errorf("expected the labeled message but got %v", pr.Errors)
+	}
+}
+
+func TestNew_Warnings(t *testing.T) {
+	is := issers.NewRoot()
+	is.WithLabeledField("password", "Password", func(is *issers.Is) {
+		is.AsWarnings(func(is *issers.Is) {
+			is.StringLengthGreaterThanOrEqual("hunter2", 12, nil)
+		})
+	})
+	pr := New(is, defTestMessagePrinter)
+	if len(pr.Errors) != 0 {
+		t.Errorf("expected no errors but got %v", pr.Errors)
+	}
+	expected := Error{
+		Pointer: "/password",
+		Detail:  "Password length should be greater than or equal to 12",
+		Code:    issers.CodeStringLengthGreaterThanOrEqual,
+	}
+	if len(pr.Warnings) != 1 || !reflect.DeepEqual(pr.Warnings[0], expected) {
+		t.Errorf("expected %v but got %v", expected, pr.Warnings)
+	}
+}
+
+func TestNew_References(t *testing.T) {
+	is := issers.NewRoot()
+	is.WithField("password_confirmation", func(is *issers.Is) {
+		is.ConfirmationOf("hunter3", "hunter2", tree.NewPath().DownField("password"), nil)
+	})
+	pr := New(is, defTestMessagePrinter)
+	expected := []Error{
+		{
+			Pointer:    "/password_confirmation",
+			Detail:     "should match password",
+			Code:       issers.CodeConfirmation,
+			References: []string{"/password"},
+		},
+	}
+	if !reflect.DeepEqual(pr.Errors, expected) {
+		t.Errorf("expected %v but got %v", expected, pr.Errors)
+	}
+}
+
+func TestProblem_Render(t *testing.T) {
+	w := httptest.NewRecorder()
+	if err := New(newTestIs(), defTestMessagePrinter).Render(w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d but got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != ContentType {
+		t.Errorf(`expected content type "%s" but got "%s"`, ContentType, ct)
+	}
+}
@@ -14,8 +14,14 @@ type ErrorNode struct {
 	NamedChildren map[string]*ErrorNode
 	// NumberedChildren are the index positions (sparse) of this node, if any
 	NumberedChildren map[int]*ErrorNode
+	// KeyedChildren are the map values of this node by key, if any
+	KeyedChildren map[string]*ErrorNode
 	// errs is the list of errors for this node. If there are errs, there should not be any children
 	errs []ifaces.ValidateError
+	// warnings is the list of advisory findings for this node, see AddWarning
+	warnings []ifaces.ValidateError
+	// label is the human-readable name of the field, see SetLabel
+	label string
 }
 
 // NewErrorNode creates a new node in the tree using the
@@ -27,6 +33,7 @@ func NewErrorNode(parent *ErrorNode) *ErrorNode {
 		parent:           parent,
 		NamedChildren:    nil,
 		NumberedChildren: nil,
+		KeyedChildren:    nil,
 		errs:             nil,
 	}
 }
@@ -70,6 +77,42 @@ func (n *ErrorNode) DownIndex(index int) (e *ErrorNode) {
 	return e
 }
 
+// DownKey creates a new node or traverses into the node if missing and returns it
+func (n *ErrorNode) DownKey(key string) (e *ErrorNode) {
+	var ok bool
+	if n.KeyedChildren == nil {
+		n.KeyedChildren = make(map[string]*ErrorNode)
+	}
+	if e, ok = n.KeyedChildren[key]; !ok {
+		e = NewErrorNode(n)
+		n.KeyedChildren[key] = e
+	}
+	return e
+}
+
+// Down creates a new node or traverses into the node addressed by the path segment and returns it
+func (n *ErrorNode) Down(s Segment) *ErrorNode {
+	if s.IsIndex() {
+		return n.DownIndex(s.Index())
+	}
+	if s.IsKey() {
+		return n.DownKey(s.Key())
+	}
+	return n.DownField(s.FieldName())
+}
+
+// child returns the existing node addressed by the path segment, or nil if it was never created.
+// Does not create nodes
+func (n *ErrorNode) child(s Segment) *ErrorNode {
+	if s.IsIndex() {
+		return n.NumberedChildren[s.Index()]
+	}
+	if s.IsKey() {
+		return n.KeyedChildren[s.Key()]
+	}
+	return n.NamedChildren[s.FieldName()]
+}
+
 // HasErrors is true if there is at least 1 error in itself OR its children
 func (n ErrorNode) HasErrors() bool {
 	if n.errs != nil && len(n.errs) != 0 {
@@ -89,6 +132,13 @@ func (n ErrorNode) HasErrors() bool {
 			}
 		}
 	}
+	if n.KeyedChildren != nil {
+		for _, c := range n.KeyedChildren {
+			if c.HasErrors() {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -113,20 +163,9 @@ func (n *ErrorNode) HasErrorAt(path Path) bool {
 // Does not create nodes. Does not alter the nodes in anyway.
 func (n *ErrorNode) traverseTo(path Path) *ErrorNode {
 	current := n
-	if path.EachComponent(func(fieldName string) bool {
-		if c, ok := current.NamedChildren[fieldName]; !ok {
-			return false
-		} else {
-			current = c
-			return true
-		}
-	}, func(index int) bool {
-		if c, ok := current.NumberedChildren[index]; !ok {
-			return false
-		} else {
-			current = c
-			return true
-		}
+	if path.EachSegment(func(s Segment) bool {
+		current = current.child(s)
+		return current != nil
 	}) {
 		return current
 	}
@@ -155,48 +194,122 @@ func (n *ErrorNode) Add(e ifaces.ValidateError) {
 	n.errs = append(n.errs, e)
 }
 
-// IsEqual attempts to ensure that the current node has the same errors and sub-errors as the provided node.
-// IsEqual will attempt to compare itself first before recursing into child nodes
-func (n *ErrorNode) IsEqual(o *ErrorNode) bool {
-	// First, check the errors locally
-	if n.errs != nil && o.errs == nil || n.errs == nil && o.errs != nil {
-		return false // one had errors, but the other did not
+// Merge copies the errors of src and its children into n, creating nodes in n as needed.
+// The errors of src are appended, in order, after any errors already in the matching node of n.
+// An error of src is skipped if the matching node of n already had an error that IsEqual to it
+// before merging. Warnings are merged the same way. Labels of src are copied to nodes of n that have none. src is not modified
+// @return added the number of errors added to n
+// @return warningsAdded the number of warnings added to n
+func (n *ErrorNode) Merge(src *ErrorNode) (added, warningsAdded int) {
+	added, warningsAdded, _ = n.merge(src, -1, true)
+	return added, warningsAdded
+}
+
+// Append is Merge, but every error and warning of src is added, even if an equal one is
+// already in the matching node of n. The result is the same as if the errors of src
+// had been added to n directly
+// @return added the number of errors added to n
+// @return warningsAdded the number of warnings added to n
+func (n *ErrorNode) Append(src *ErrorNode) (added, warningsAdded int) {
+	added, warningsAdded, _ = n.merge(src, -1, false)
+	return added, warningsAdded
+}
+
+// MergeAtMost is Merge, but at most limit errors are added to n. The nodes of src are
+// merged in the order Walk visits them, so the errors that are kept are the first
+// ones Walk would produce. Warnings are not limited
+// @param limit is the most errors to add. It must not be negative
+// @return added the number of errors added to n
+// @return warningsAdded the number of warnings added to n
+// @return dropped the number of errors of src that were not added because of the limit.
+//   Errors skipped because they were already in n are not counted
+func (n *ErrorNode) MergeAtMost(src *ErrorNode, limit int) (added, warningsAdded, dropped int) {
+	if limit < 0 {
+		panic("limit cannot be negative")
 	}
+	return n.merge(src, limit, true)
+}
 
-	// Compare this node's errors
-	// both either have errors or do not have errors at this point
-	if n.errs != nil {
-		// different lengths
-		if len(n.errs) != len(o.errs) {
-			return false
-		}
+// AppendAtMost is Append, but at most limit errors are added to n, like MergeAtMost
+// @param limit is the most errors to add. It must not be negative
+// @return added the number of errors added to n
+// @return warningsAdded the number of warnings added to n
+// @return dropped the number of errors of src that were not added because of the limit
+func (n *ErrorNode) AppendAtMost(src *ErrorNode, limit int) (added, warningsAdded, dropped int) {
+	if limit < 0 {
+		panic("limit cannot be negative")
+	}
+	return n.merge(src, limit, false)
+}
 
-		// both have errors and both are the same length
-		// Create a list of errors to "mark" them as no yet compared
-		unVisitedErrors := list.New()
-		for _, e := range o.errs {
-			unVisitedErrors.PushFront(e)
+// merge is the recursive implementation of Merge, MergeAtMost, Append and AppendAtMost
+// @param room is the most errors that may be added, or -1 if there is no limit
+// @param dedupe skips the errors and warnings of src that are already in n
+func (n *ErrorNode) merge(src *ErrorNode, room int, dedupe bool) (added, warningsAdded, dropped int) {
+	if src == nil {
+		return 0, 0, 0
+	}
+	if len(n.label) == 0 {
+		n.label = src.label
+	}
+	existing := n.errs
+	for _, e := range src.errs {
+		if dedupe && containsError(existing, e) {
+			continue
 		}
-
-		// Both have errors, check 'em
-		for _, e := range n.errs {
-			for i := unVisitedErrors.Front(); i != nil; {
-				if e.IsEqual(i.Value.(ifaces.ValidateError)) {
-					c := i
-					i = c.Next()
-					// We've visited this error, mark it as visited by removing it from the list
-					unVisitedErrors.Remove(c)
-				} else {
-					i = i.Next()
-				}
-			}
+		if room == added {
+			dropped++
+			continue
+		}
+		n.Add(e)
+		added++
+	}
+	existingWarnings := n.warnings
+	for _, e := range src.warnings {
+		if !dedupe || !containsError(existingWarnings, e) {
+			n.AddWarning(e)
+			warningsAdded++
+		}
+	}
+	mergeChild := func(dst, c *ErrorNode) {
+		childRoom := -1
+		if room >= 0 {
+			childRoom = room - added
 		}
+		a, w, d := dst.merge(c, childRoom, dedupe)
+		added += a
+		warningsAdded += w
+		dropped += d
+	}
+	for _, name := range sortedKeys(src.NamedChildren) {
+		mergeChild(n.DownField(name), src.NamedChildren[name])
+	}
+	for _, key := range sortedKeys(src.KeyedChildren) {
+		mergeChild(n.DownKey(key), src.KeyedChildren[key])
+	}
+	for _, index := range sortedIndexes(src.NumberedChildren) {
+		mergeChild(n.DownIndex(index), src.NumberedChildren[index])
+	}
+	return added, warningsAdded, dropped
+}
 
-		// We didn't remove all of the errors, that means some were not in unVisitedErrors
-		if unVisitedErrors.Len() != 0 {
-			return false
+// containsError returns true if at least 1 of errs IsEqual to e
+func containsError(errs []ifaces.ValidateError, e ifaces.ValidateError) bool {
+	for _, existing := range errs {
+		if existing.IsEqual(e) {
+			return true
 		}
 	}
+	return false
+}
+
+// IsEqual attempts to ensure that the current node has the same errors, warnings and sub-errors as the provided node.
+// IsEqual will attempt to compare itself first before recursing into child nodes
+func (n *ErrorNode) IsEqual(o *ErrorNode) bool {
+	// First, check the errors and warnings locally
+	if !sameErrors(n.errs, o.errs) || !sameErrors(n.warnings, o.warnings) {
+		return false
+	}
 
 	// Numbered children
 	{
@@ -216,8 +329,8 @@ func (n *ErrorNode) IsEqual(o *ErrorNode) bool {
 			if len(nn) != len(oo) {
 				return false
 			}
-			for i := 0; i < len(nn); i++ {
-				if !nn[i].IsEqual(oo[i]) {
+			for i := range nn {
+				if c, ok := oo[i]; !ok || !nn[i].IsEqual(c) {
 					return false
 				}
 			}
@@ -243,12 +356,79 @@ func (n *ErrorNode) IsEqual(o *ErrorNode) bool {
 				return false
 			}
 			for i := range nn {
-				if !nn[i].IsEqual(oo[i]) {
+				if c, ok := oo[i]; !ok || !nn[i].IsEqual(c) {
 					return false
 				}
 			}
 		}
 	}
 
+	// Keyed children
+	{
+		nn := n.KeyedChildren
+		oo := o.KeyedChildren
+
+		if nn != nil && oo == nil {
+			return false
+		}
+		if oo != nil && nn == nil {
+			return false
+		}
+
+		if nn != nil {
+			if len(nn) != len(oo) {
+				return false
+			}
+			for k := range nn {
+				if c, ok := oo[k]; !ok || !nn[k].IsEqual(c) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// sameErrors returns true if both lists hold the same errors, in any order
+func sameErrors(nn, oo []ifaces.ValidateError) bool {
+	if nn != nil && oo == nil || nn == nil && oo != nil {
+		return false // one had errors, but the other did not
+	}
+
+	// Compare the errors
+	// both either have errors or do not have errors at this point
+	if nn != nil {
+		// different lengths
+		if len(nn) != len(oo) {
+			return false
+		}
+
+		// both have errors and both are the same length
+		// Create a list of errors to "mark" them as no yet compared
+		unVisitedErrors := list.New()
+		for _, e := range oo {
+			unVisitedErrors.PushFront(e)
+		}
+
+		// Both have errors, check 'em
+		for _, e := range nn {
+			for i := unVisitedErrors.Front(); i != nil; {
+				if e.IsEqual(i.Value.(ifaces.ValidateError)) {
+					c := i
+					i = c.Next()
+					// We've visited this error, mark it as visited by removing it from the list
+					unVisitedErrors.Remove(c)
+				} else {
+					i = i.Next()
+				}
+			}
+		}
+
+		// We didn't remove all of the errors, that means some were not in unVisitedErrors
+		if unVisitedErrors.Len() != 0 {
+			return false
+		}
+	}
 	return true
 }
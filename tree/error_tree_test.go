@@ -0,0 +1,113 @@
+package tree
+
+import "testing"
+
+func TestErrorNode_Merge(t *testing.T) {
+	dst := NewErrorNode(nil)
+	dst.DownField("name").Add(testValidateError("short"))
+
+	src := NewErrorNode(nil)
+	src.DownField("name").Add(testValidateError("odd"))
+	src.DownField("emails").DownIndex(3).Add(testValidateError("bad"))
+	src.DownField("labels").DownKey("a/b").Add(testValidateError("bad"))
+
+	added, _ := dst.Merge(src)
+	if added != 3 {
+		t.Errorf("expected 3 errors to be added but got %d", added)
+	}
+
+	expected := NewErrorNode(nil)
+	expected.DownField("name").Add(testValidateError("short"))
+	expected.DownField("name").Add(testValidateError("odd"))
+	expected.DownField("emails").DownIndex(3).Add(testValidateError("bad"))
+	expected.DownField("labels").DownKey("a/b").Add(testValidateError("bad"))
+	if !expected.IsEqual(dst) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *dst)
+	}
+
+	names := dst.DownField("name").Errors()
+	if names[0] != testValidateError("short") || names[1] != testValidateError("odd") {
+		t.Errorf("expected merged errors to be appended in order, got %v", names)
+	}
+
+	if len(src.DownField("name").Errors()) != 1 {
+		t.Error("expected src to be unmodified")
+	}
+}
+
+func TestErrorNode_MergeDeduplicates(t *testing.T) {
+	dst := NewErrorNode(nil)
+	dst.DownField("name").Add(testValidateError("short"))
+
+	src := NewErrorNode(nil)
+	src.DownField("name").Add(testValidateError("odd"))
+	src.DownField("name").Add(testValidateError("short"))
+	src.DownField("name").Add(testValidateError("odd"))
+
+	added, _ := dst.Merge(src)
+	if added != 2 {
+		t.Errorf("expected 2 errors to be added but got %d", added)
+	}
+	names := dst.DownField("name").Errors()
+	expected := []testValidateError{"short", "odd", "odd"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %v but got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected %v but got %v", expected, names)
+		}
+	}
+}
+
+func TestErrorNode_MergeAtMost(t *testing.T) {
+	src := NewErrorNode(nil)
+	src.DownField("name").Add(testValidateError("short"))
+	src.DownField("name").Add(testValidateError("odd"))
+	src.DownField("emails").DownIndex(3).Add(testValidateError("bad"))
+	src.DownField("emails").DownIndex(1).Add(testValidateError("bad"))
+
+	dst := NewErrorNode(nil)
+	dst.DownField("name").Add(testValidateError("short"))
+	added, _, dropped := dst.MergeAtMost(src, 2)
+	if added != 2 || dropped != 1 {
+		t.Errorf("expected 2 errors to be added and 1 dropped but got %d and %d", added, dropped)
+	}
+
+	// "emails" is visited before "name", as with Walk
+	expected := NewErrorNode(nil)
+	expected.DownField("name").Add(testValidateError("short"))
+	expected.DownField("emails").DownIndex(1).Add(testValidateError("bad"))
+	expected.DownField("emails").DownIndex(3).Add(testValidateError("bad"))
+	if !expected.IsEqual(dst) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *dst)
+	}
+}
+
+func TestErrorNode_Append(t *testing.T) {
+	src := NewErrorNode(nil)
+	src.DownField("name").Add(testValidateError("short"))
+	src.DownField("name").AddWarning(testValidateError("odd"))
+
+	dst := NewErrorNode(nil)
+	dst.DownField("name").Add(testValidateError("short"))
+	dst.DownField("name").AddWarning(testValidateError("odd"))
+	added, warningsAdded := dst.Append(src)
+	if added != 1 || warningsAdded != 1 {
+		t.Errorf("expected 1 error and 1 warning to be added but got %d and %d", added, warningsAdded)
+	}
+
+	expected := NewErrorNode(nil)
+	expected.DownField("name").Add(testValidateError("short"))
+	expected.DownField("name").Add(testValidateError("short"))
+	expected.DownField("name").AddWarning(testValidateError("odd"))
+	expected.DownField("name").AddWarning(testValidateError("odd"))
+	if !expected.IsEqual(dst) {
+		t.Errorf("errors were not the same, expected: %v, got %v", *expected, *dst)
+	}
+
+	added, _, dropped := dst.AppendAtMost(src, 0)
+	if added != 0 || dropped != 1 {
+		t.Errorf("expected no errors to be added and 1 dropped but got %d and %d", added, dropped)
+	}
+}
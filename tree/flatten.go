@@ -0,0 +1,132 @@
+package tree
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// PathStyle selects how Path.Format writes a path
+type PathStyle int
+
+const (
+	// PathStyleNative is the form returned by Path.String, e.g.: /a/b[0]/c
+	PathStyleNative PathStyle = iota
+
+	// PathStyleDotted is the JavaScript-like form, e.g.: a.b[0].c
+	// Map keys are written as quoted strings in brackets, e.g.: labels["a.b"].
+	// A "." or "\" in a field name is escaped with a "\", e.g.: the field "a.b" is a\.b
+	PathStyleDotted
+
+	// PathStyleBracketed is the form PHP and Rails use for form field names, e.g.: a[b][0][c]
+	// A "[", "]" or "\" in a field name or map key is escaped with a "\", e.g.: the key "a]" is a\]
+	PathStyleBracketed
+
+	// PathStyleJSONPointer is the RFC 6901 JSON Pointer form, e.g.: /a/b/0/c
+	PathStyleJSONPointer
+)
+
+// dottedFieldEscaper escapes the field names in PathStyleDotted, so that "a.b" is not read as a field of "a"
+var dottedFieldEscaper = strings.NewReplacer(`\`, `\\`, `.`, `\.`)
+
+// bracketedEscaper escapes the components in PathStyleBracketed, so that the brackets of a
+// component are not read as the start or end of another
+var bracketedEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+
+// Format writes the path in the requested style. The root is "/" in the
+// native style and the empty string in every other style
+func (p Path) Format(style PathStyle) string {
+	switch style {
+	case PathStyleDotted:
+		return p.dotted()
+	case PathStyleBracketed:
+		return p.bracketed()
+	case PathStyleJSONPointer:
+		return p.JSONPointer()
+	default:
+		return p.String()
+	}
+}
+
+// dotted writes the path as a.b[0].c
+func (p Path) dotted() string {
+	var b strings.Builder
+	p.EachSegment(func(s Segment) bool {
+		if s.IsIndex() {
+			b.WriteString("[")
+			b.WriteString(strconv.Itoa(s.Index()))
+			b.WriteString("]")
+		} else if s.IsKey() {
+			b.WriteString("[")
+			b.WriteString(strconv.Quote(s.Key()))
+			b.WriteString("]")
+		} else {
+			if b.Len() != 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(dottedFieldEscaper.Replace(s.FieldName()))
+		}
+		return true
+	})
+	return b.String()
+}
+
+// bracketed writes the path as a[b][0][c]
+func (p Path) bracketed() string {
+	var b strings.Builder
+	p.EachSegment(func(s Segment) bool {
+		var component string
+		if s.IsIndex() {
+			component = strconv.Itoa(s.Index())
+		} else if s.IsKey() {
+			component = bracketedEscaper.Replace(s.Key())
+		} else {
+			component = bracketedEscaper.Replace(s.FieldName())
+		}
+		if b.Len() == 0 {
+			b.WriteString(component)
+		} else {
+			b.WriteString("[")
+			b.WriteString(component)
+			b.WriteString("]")
+		}
+		return true
+	})
+	return b.String()
+}
+
+// Flatten returns every error in the tree as localized messages, keyed by
+// the path to the node holding them written in the requested style.
+// Messages for a path keep the order in which they were recorded and use the label of the node, if any
+// @param printer is used to localize each ValidateError. If nil, American English is used
+// @param style of the paths used as keys
+//
+// @example
+// ```go
+// errs := is.Errors().Flatten(p, tree.PathStyleDotted)
+// // errs["name.first"] == []string{"should be present"}
+// ```
+func (n *ErrorNode) Flatten(printer *message.Printer, style PathStyle) map[string][]string {
+	return n.flatten(printer, style, SeverityError)
+}
+
+// FlattenWarnings is Flatten, but for the warnings
+func (n *ErrorNode) FlattenWarnings(printer *message.Printer, style PathStyle) map[string][]string {
+	return n.flatten(printer, style, SeverityWarning)
+}
+
+// flatten is the implementation of Flatten and FlattenWarnings
+func (n *ErrorNode) flatten(printer *message.Printer, style PathStyle, severity Severity) map[string][]string {
+	if printer == nil {
+		printer = message.NewPrinter(language.AmericanEnglish)
+	}
+	out := make(map[string][]string)
+	_ = n.walk(NewPath(), severity, func(path Path, node *ErrorNode) error {
+		key := path.Format(style)
+		out[key] = append(out[key], node.messages(printer, severity)...)
+		return nil
+	})
+	return out
+}
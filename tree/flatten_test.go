@@ -0,0 +1,112 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPath_Format(t *testing.T) {
+	p := NewPath().DownField("bob").DownField("phones").DownIndex(0).DownField("labels").DownKey("a.b")
+	cases := map[PathStyle]string{
+		PathStyleNative:      `/bob/phones[0]/labels["a.b"]`,
+		PathStyleDotted:      `bob.phones[0].labels["a.b"]`,
+		PathStyleBracketed:   `bob[phones][0][labels][a.b]`,
+		PathStyleJSONPointer: `/bob/phones/0/labels/a.b`,
+	}
+	for style, expected := range cases {
+		if actual := p.Format(style); actual != expected {
+			t.Errorf(`style %d: expected "%s" but got "%s"`, style, expected, actual)
+		}
+	}
+
+	roots := map[PathStyle]string{
+		PathStyleNative:      "/",
+		PathStyleDotted:      "",
+		PathStyleBracketed:   "",
+		PathStyleJSONPointer: "",
+	}
+	for style, expected := range roots {
+		if actual := NewPath().Format(style); actual != expected {
+			t.Errorf(`style %d: expected root to be "%s" but got "%s"`, style, expected, actual)
+		}
+	}
+}
+
+func TestPath_FormatEscapes(t *testing.T) {
+	cases := []struct {
+		style    PathStyle
+		path     Path
+		expected string
+	}{
+		{style: PathStyleDotted, path: NewPath().DownField("a.b"), expected: `a\.b`},
+		{style: PathStyleDotted, path: NewPath().DownField("a").DownField("b"), expected: `a.b`},
+		{style: PathStyleDotted, path: NewPath().DownField(`a\`).DownField("b"), expected: `a\\.b`},
+		{style: PathStyleBracketed, path: NewPath().DownField("x").DownKey("a][b"), expected: `x[a\]\[b]`},
+		{style: PathStyleBracketed, path: NewPath().DownField("x").DownKey("a").DownKey("b"), expected: `x[a][b]`},
+		{style: PathStyleBracketed, path: NewPath().DownField("x").DownKey(`a\`), expected: `x[a\\]`},
+	}
+	for _, c := range cases {
+		if actual := c.path.Format(c.style); actual != c.expected {
+			t.Errorf(`style %d: expected "%s" but got "%s"`, c.style, c.expected, actual)
+		}
+	}
+}
+
+func TestErrorNode_FlattenDoesNotCollide(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("a.b").Add(testValidateError("dotted"))
+	e.DownField("a").DownField("b").Add(testValidateError("nested"))
+	e.DownField("x").DownKey("a][b").Add(testValidateError("bracketed"))
+	e.DownField("x").DownKey("a").DownKey("b").Add(testValidateError("nested"))
+
+	for _, style := range []PathStyle{PathStyleNative, PathStyleDotted, PathStyleBracketed, PathStyleJSONPointer} {
+		if actual := e.Flatten(nil, style); len(actual) != 4 {
+			t.Errorf("style %d: expected 4 distinct paths but got %v", style, actual)
+		}
+	}
+}
+
+func TestErrorNode_Flatten(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("name").DownField("first").Add(testValidateError("missing"))
+	e.DownField("name").DownField("first").Add(testValidateError("short"))
+	e.DownField("emails").DownIndex(1).Add(testValidateError("bad"))
+
+	cases := map[PathStyle]map[string][]string{
+		PathStyleNative: {
+			"/name/first": {"missing", "short"},
+			"/emails[1]":  {"bad"},
+		},
+		PathStyleDotted: {
+			"name.first": {"missing", "short"},
+			"emails[1]":  {"bad"},
+		},
+		PathStyleBracketed: {
+			"name[first]": {"missing", "short"},
+			"emails[1]":   {"bad"},
+		},
+		PathStyleJSONPointer: {
+			"/name/first": {"missing", "short"},
+			"/emails/1":   {"bad"},
+		},
+	}
+	for style, expected := range cases {
+		if actual := e.Flatten(nil, style); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("style %d: expected %v but got %v", style, expected, actual)
+		}
+	}
+}
+
+func TestErrorNode_FlattenLabeled(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("name").SetLabel("Name")
+	e.DownField("name").Add(testLabeledValidateError("missing"))
+	e.DownField("name").Add(testValidateError("short"))
+
+	expected := map[string][]string{
+		"name": {"Name: missing", "short"},
+	}
+	if actual := e.Flatten(nil, PathStyleDotted); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+}
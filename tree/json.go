@@ -0,0 +1,251 @@
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/wojnosystems/validates/ifaces"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// DefaultJSONErrorsKey is the object key used to hold a node's own errors
+// when that node also has children and must be rendered as an object
+var DefaultJSONErrorsKey = "_errors"
+
+// JSONOptions controls how an ErrorNode is rendered as JSON
+type JSONOptions struct {
+	// Printer is used to localize each ValidateError. If nil, American English is used
+	Printer *message.Printer
+
+	// ErrorsKey is the key used for a node's own errors when the node also has children.
+	// If empty, DefaultJSONErrorsKey is used
+	ErrorsKey string
+
+	// IncludeCodes renders each error as an object: {"message": "...", "code": "..."}
+	// instead of just the message. The code is omitted for errors that do not implement ifaces.Coder
+	IncludeCodes bool
+
+	// Severity selects whether the errors or the warnings are rendered. Nodes
+	// without findings of this severity are left out. The default is SeverityError
+	Severity Severity
+}
+
+// jsonError is how an error is rendered when JSONOptions.IncludeCodes is set
+type jsonError struct {
+	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
+}
+
+// jsonErrorNode binds an ErrorNode to the options used to render it
+type jsonErrorNode struct {
+	node *ErrorNode
+	opts JSONOptions
+}
+
+// JSON returns a json.Marshaler that renders the node using the provided options
+//
+// @example
+// ```go
+// body, err := json.Marshal(map[string]json.Marshaler{
+//   "errors":   is.Errors().JSON(tree.JSONOptions{Printer: p}),
+//   "warnings": is.Errors().JSON(tree.JSONOptions{Printer: p, Severity: tree.SeverityWarning}),
+// })
+// ```
+func (n *ErrorNode) JSON(opts JSONOptions) json.Marshaler {
+	if opts.Printer == nil {
+		opts.Printer = message.NewPrinter(language.AmericanEnglish)
+	}
+	if len(opts.ErrorsKey) == 0 {
+		opts.ErrorsKey = DefaultJSONErrorsKey
+	}
+	return &jsonErrorNode{
+		node: n,
+		opts: opts,
+	}
+}
+
+// MarshalJSON renders the node using the default JSONOptions. See JSON for details
+func (n *ErrorNode) MarshalJSON() ([]byte, error) {
+	return n.JSON(JSONOptions{}).MarshalJSON()
+}
+
+// MarshalJSON emits a nested document that mirrors the validated structure:
+//
+//   - a node with only errors is an array of localized messages
+//   - a node with only numbered children is an array, indexes without errors are null
+//   - any other node is an object keyed by field name or map key, with numbered children
+//     keyed by their decimal index and the node's own errors stored under ErrorsKey
+//
+// The root is always an object, even when there are no errors.
+// Each node is placed in the document by following the components of its Path, as per
+// Path.EachComponent, so keys match the paths that Is.WithField and Is.WithIndex produced
+// @return err if the tree can't be rendered without losing errors: a negative index, a
+//   field and a map key with the same name, or a field, map key or decimal index that
+//   is the same as ErrorsKey or as another member of the same object
+func (j *jsonErrorNode) MarshalJSON() ([]byte, error) {
+	if j.node.IsRoot() && !j.node.has(j.opts.Severity) {
+		return []byte("{}"), nil
+	}
+	if err := j.checkChildren(NewPath(), j.node); err != nil {
+		return nil, err
+	}
+	doc := &jsonDoc{}
+	err := j.node.walk(NewPath(), j.opts.Severity, func(path Path, n *ErrorNode) error {
+		current := doc
+		var err error
+		path.EachComponent(func(fieldName string) bool {
+			current = current.member(fieldName)
+			return true
+		}, func(index int) bool {
+			if index < 0 {
+				err = fmt.Errorf("tree: cannot render the negative index at %s as JSON", path)
+				return false
+			}
+			current = current.item(index)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		current.messages = j.messages(n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	value, err := doc.value("", j.opts.ErrorsKey)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// checkChildren returns an error if a field and a map key of n, or of its children, have the
+// same name. Path.EachComponent does not tell them apart, so they would be rendered as the same
+// member. Only children with findings of the rendered severity are checked
+func (j *jsonErrorNode) checkChildren(path Path, n *ErrorNode) error {
+	for _, key := range sortedKeys(n.KeyedChildren) {
+		if named, ok := n.NamedChildren[key]; ok && n.KeyedChildren[key].has(j.opts.Severity) && named.has(j.opts.Severity) {
+			return fmt.Errorf(`tree: the field and the map key "%s" at %s are the same JSON member`, key, path)
+		}
+	}
+	for _, name := range sortedKeys(n.NamedChildren) {
+		if err := j.checkChildren(path.DownField(name), n.NamedChildren[name]); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedKeys(n.KeyedChildren) {
+		if err := j.checkChildren(path.DownKey(key), n.KeyedChildren[key]); err != nil {
+			return err
+		}
+	}
+	for _, index := range sortedIndexes(n.NumberedChildren) {
+		if err := j.checkChildren(path.DownIndex(index), n.NumberedChildren[index]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonDoc is a node of the document being rendered, built by following the components of the paths
+type jsonDoc struct {
+	// members are the fields and map values, by name or key
+	members map[string]*jsonDoc
+	// items are the array elements, by index
+	items map[int]*jsonDoc
+	// messages are the rendered errors (or warnings) of the node, if any
+	messages []interface{}
+}
+
+// member returns the member with the name, creating it if missing
+func (d *jsonDoc) member(name string) *jsonDoc {
+	if d.members == nil {
+		d.members = make(map[string]*jsonDoc)
+	}
+	if _, ok := d.members[name]; !ok {
+		d.members[name] = &jsonDoc{}
+	}
+	return d.members[name]
+}
+
+// item returns the array element at the index, creating it if missing
+func (d *jsonDoc) item(index int) *jsonDoc {
+	if d.items == nil {
+		d.items = make(map[int]*jsonDoc)
+	}
+	if _, ok := d.items[index]; !ok {
+		d.items[index] = &jsonDoc{}
+	}
+	return d.items[index]
+}
+
+// value converts the document into a value that encoding/json can render
+// @param pointer is the JSON Pointer to d, used to report collisions
+func (d *jsonDoc) value(pointer, errorsKey string) (interface{}, error) {
+	if len(d.members) == 0 && len(d.items) == 0 {
+		return d.messages, nil
+	}
+	if len(d.members) == 0 && len(d.messages) == 0 {
+		maxIndex := -1
+		for index := range d.items {
+			maxIndex = max(maxIndex, index)
+		}
+		out := make([]interface{}, maxIndex+1)
+		for index, c := range d.items {
+			v, err := c.value(pointer+"/"+strconv.Itoa(index), errorsKey)
+			if err != nil {
+				return nil, err
+			}
+			out[index] = v
+		}
+		return out, nil
+	}
+	out := make(map[string]interface{}, len(d.members)+len(d.items)+1)
+	for name, c := range d.members {
+		v, err := c.value(pointer+"/"+jsonPointerEscaper.Replace(name), errorsKey)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = v
+	}
+	for index, c := range d.items {
+		key := strconv.Itoa(index)
+		if _, ok := out[key]; ok {
+			return nil, fmt.Errorf(`tree: the index %d and the member "%s" at "%s" are the same JSON member`, index, key, pointer)
+		}
+		v, err := c.value(pointer+"/"+key, errorsKey)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+	}
+	if len(d.messages) != 0 {
+		if _, ok := out[errorsKey]; ok {
+			return nil, fmt.Errorf(`tree: the member "%s" at "%s" is the same as the errors key`, errorsKey, pointer)
+		}
+		out[errorsKey] = d.messages
+	}
+	return out, nil
+}
+
+// messages localizes the errors (or warnings) local to the node, using the node's label
+func (j *jsonErrorNode) messages(n *ErrorNode) []interface{} {
+	findings := n.findings(j.opts.Severity)
+	out := make([]interface{}, 0, len(findings))
+	for _, e := range findings {
+		if !j.opts.IncludeCodes {
+			out = append(out, Localize(e, j.opts.Printer, n.label))
+			continue
+		}
+		je := jsonError{
+			Message: Localize(e, j.opts.Printer, n.label),
+		}
+		if c, ok := e.(ifaces.Coder); ok {
+			je.Code = c.Code()
+		}
+		out = append(out, je)
+	}
+	return out
+}
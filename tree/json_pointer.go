@@ -0,0 +1,94 @@
+package tree
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPointerEscaper escapes reference tokens as per RFC 6901 section 3.
+// "~" must be escaped before "/" so that the "~" in "~1" is left alone
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// jsonPointerUnescaper reverses jsonPointerEscaper. "~1" must be unescaped before "~0"
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// JSONPointer returns the RFC 6901 JSON Pointer that references the same location as this path.
+// The root is the empty string, which references the whole document. Indexes become numeric tokens.
+// Examples:
+// / = ""
+// /bob/phones[0] = "/bob/phones/0"
+func (p Path) JSONPointer() string {
+	var b strings.Builder
+	p.EachComponent(func(fieldName string) bool {
+		b.WriteString("/")
+		b.WriteString(jsonPointerEscaper.Replace(fieldName))
+		return true
+	}, func(index int) bool {
+		b.WriteString("/")
+		b.WriteString(strconv.Itoa(index))
+		return true
+	})
+	return b.String()
+}
+
+// ParseJSONPointer converts an RFC 6901 JSON Pointer into a Path.
+// Tokens that are array indexes as per RFC 6901 section 4 ("0" or a number without leading zeros)
+// become indexes. Tokens that are valid field names become fields, all other tokens (e.g.: "a/b")
+// become map keys.
+// @return err if the pointer is malformed
+func ParseJSONPointer(pointer string) (p Path, err error) {
+	p = NewPath()
+	if len(pointer) == 0 {
+		return p, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return p, fmt.Errorf(`json pointer must be empty or start with "/": %s`, pointer)
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		if !isValidJSONPointerToken(token) {
+			return NewPath(), fmt.Errorf(`json pointer has an invalid escape sequence in token: %s`, token)
+		}
+		if isJSONPointerIndex(token) {
+			index, err := strconv.Atoi(token)
+			if err != nil {
+				return NewPath(), fmt.Errorf("json pointer index is out of range: %s", token)
+			}
+			p = p.DownIndex(index)
+			continue
+		}
+		name := jsonPointerUnescaper.Replace(token)
+		if isValidFieldName(name) {
+			p = p.DownField(name)
+		} else {
+			p = p.DownKey(name)
+		}
+	}
+	return p, nil
+}
+
+// isValidJSONPointerToken returns true if every "~" in the token is followed by a "0" or "1"
+func isValidJSONPointerToken(token string) bool {
+	for i := 0; i < len(token); i++ {
+		if token[i] == '~' {
+			if i+1 == len(token) || (token[i+1] != '0' && token[i+1] != '1') {
+				return false
+			}
+			i++
+		}
+	}
+	return true
+}
+
+// isJSONPointerIndex returns true if the token is "0" or a run of digits without a leading zero
+func isJSONPointerIndex(token string) bool {
+	if len(token) == 0 || (token[0] == '0' && len(token) != 1) {
+		return false
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
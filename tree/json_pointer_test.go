@@ -0,0 +1,92 @@
+package tree
+
+import "testing"
+
+func TestPath_JSONPointer(t *testing.T) {
+	cases := []struct {
+		path     Path
+		expected string
+	}{
+		{
+			path:     NewPath(),
+			expected: "",
+		},
+		{
+			path:     NewPath().DownField("puppy"),
+			expected: "/puppy",
+		},
+		{
+			path:     NewPath().DownField("bob").DownField("phones").DownIndex(0),
+			expected: "/bob/phones/0",
+		},
+		{
+			path:     NewPath().DownField("puppy").DownIndex(1).DownIndex(33).DownField("zo~ey"),
+			expected: "/puppy/1/33/zo~0ey",
+		},
+		{
+			path:     NewPath().DownField("labels").DownKey("a/b"),
+			expected: "/labels/a~1b",
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.path.JSONPointer()
+		if actual != c.expected {
+			t.Errorf(`"%s" expected to be: "%s", but got: "%s"`, c.path.String(), c.expected, actual)
+		}
+	}
+}
+
+func TestParseJSONPointer(t *testing.T) {
+	cases := []struct {
+		pointer  string
+		expected Path
+	}{
+		{
+			pointer:  "",
+			expected: NewPath(),
+		},
+		{
+			pointer:  "/puppy",
+			expected: NewPath().DownField("puppy"),
+		},
+		{
+			pointer:  "/bob/phones/0",
+			expected: NewPath().DownField("bob").DownField("phones").DownIndex(0),
+		},
+		{
+			pointer:  "/puppy/12/01/zo~0ey",
+			expected: NewPath().DownField("puppy").DownIndex(12).DownField("01").DownField("zo~ey"),
+		},
+		{
+			pointer:  "/labels/a~1b/x[1]",
+			expected: NewPath().DownField("labels").DownKey("a/b").DownKey("x[1]"),
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseJSONPointer(c.pointer)
+		if err != nil {
+			t.Errorf(`"%s" unexpected error: %v`, c.pointer, err)
+			continue
+		}
+		if !c.expected.IsEqual(actual) {
+			t.Errorf(`"%s" expected to be: %s, but got: %s`, c.pointer, c.expected, actual)
+		}
+	}
+}
+
+func TestParseJSONPointer_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"relative":         "puppy",
+		"bad escape":       "/pup~2py",
+		"trailing tilde":   "/puppy~",
+		"index overflowed": "/99999999999999999999999",
+	}
+
+	for caseName, pointer := range cases {
+		if _, err := ParseJSONPointer(pointer); err == nil {
+			t.Errorf(`%s: expected "%s" to be rejected`, caseName, pointer)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package tree
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/wojnosystems/validates/ifaces"
+	"golang.org/x/text/message"
+)
+
+type testValidateError string
+
+func (v testValidateError) ErrorI18n(p *message.Printer) string {
+	return p.Sprint(string(v))
+}
+
+func (v testValidateError) IsEqual(e ifaces.ValidateError) bool {
+	t, ok := e.(testValidateError)
+	return ok && t == v
+}
+
+func TestErrorNode_MarshalJSON(t *testing.T) {
+	cases := map[string]struct {
+		node     func() *ErrorNode
+		expected string
+	}{
+		"empty": {
+			node: func() *ErrorNode {
+				return NewErrorNode(nil)
+			},
+			expected: `{}`,
+		},
+		"nested fields": {
+			node: func() *ErrorNode {
+				e := NewErrorNode(nil)
+				e.DownField("name").DownField("first").Add(testValidateError("missing"))
+				e.DownField("age").Add(testValidateError("too young"))
+				return e
+			},
+			expected: `{"age":["too young"],"name":{"first":["missing"]}}`,
+		},
+		"sparse array": {
+			node: func() *ErrorNode {
+				e := NewErrorNode(nil)
+				e.DownField("emails").DownIndex(2).Add(testValidateError("bad"))
+				return e
+			},
+			expected: `{"emails":[null,null,["bad"]]}`,
+		},
+		"map values": {
+			node: func() *ErrorNode {
+				e := NewErrorNode(nil)
+				e.DownField("labels").DownKey("a/b").Add(testValidateError("bad"))
+				return e
+			},
+			expected: `{"labels":{"a/b":["bad"]}}`,
+		},
+		"array with own errors": {
+			node: func() *ErrorNode {
+				e := NewErrorNode(nil)
+				emails := e.DownField("emails")
+				emails.Add(testValidateError("too few"))
+				emails.DownIndex(0).Add(testValidateError("bad"))
+				return e
+			},
+			expected: `{"emails":{"0":["bad"],"_errors":["too few"]}}`,
+		},
+	}
+
+	for caseName, c := range cases {
+		actual, err := json.Marshal(c.node())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", caseName, err)
+			continue
+		}
+		if string(actual) != c.expected {
+			t.Errorf(`%s: expected: %s but got: %s`, caseName, c.expected, string(actual))
+		}
+	}
+}
+
+func TestErrorNode_JSON(t *testing.T) {
+	e := NewErrorNode(nil)
+	name := e.DownField("name")
+	name.Add(testValidateError("incomplete"))
+	name.DownField("first").Add(testValidateError("missing"))
+
+	actual, err := json.Marshal(e.JSON(JSONOptions{ErrorsKey: "$"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"name":{"$":["incomplete"],"first":["missing"]}}`
+	if string(actual) != expected {
+		t.Errorf(`expected: %s but got: %s`, expected, string(actual))
+	}
+}
+
+type testCodedValidateError string
+
+func (v testCodedValidateError) ErrorI18n(p *message.Printer) string {
+	return p.Sprint(string(v))
+}
+
+func (v testCodedValidateError) IsEqual(e ifaces.ValidateError) bool {
+	t, ok := e.(testCodedValidateError)
+	return ok && t == v
+}
+
+func (v testCodedValidateError) Code() string {
+	return "test." + string(v)
+}
+
+func TestErrorNode_JSONIncludeCodes(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("name").Add(testCodedValidateError("missing"))
+	e.DownField("name").Add(testValidateError("uncoded"))
+
+	actual, err := json.Marshal(e.JSON(JSONOptions{IncludeCodes: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"name":[{"message":"missing","code":"test.missing"},{"message":"uncoded"}]}`
+	if string(actual) != expected {
+		t.Errorf(`expected: %s but got: %s`, expected, string(actual))
+	}
+}
+
+func TestErrorNode_MarshalJSONCollisions(t *testing.T) {
+	cases := map[string]func() *ErrorNode{
+		"negative index": func() *ErrorNode {
+			e := NewErrorNode(nil)
+			e.DownField("emails").DownIndex(-1).Add(testValidateError("bad"))
+			return e
+		},
+		"field and key": func() *ErrorNode {
+			e := NewErrorNode(nil)
+			labels := e.DownField("labels")
+			labels.DownField("a").Add(testValidateError("bad"))
+			labels.DownKey("a").Add(testValidateError("bad"))
+			return e
+		},
+		"errors key": func() *ErrorNode {
+			e := NewErrorNode(nil)
+			name := e.DownField("name")
+			name.Add(testValidateError("incomplete"))
+			name.DownField(DefaultJSONErrorsKey).Add(testValidateError("bad"))
+			return e
+		},
+		"decimal key and index": func() *ErrorNode {
+			e := NewErrorNode(nil)
+			labels := e.DownField("labels")
+			labels.DownKey("0").Add(testValidateError("bad"))
+			labels.DownIndex(0).Add(testValidateError("bad"))
+			return e
+		},
+	}
+	for caseName, node := range cases {
+		if _, err := json.Marshal(node()); err == nil {
+			t.Errorf("%s: expected an error", caseName)
+		}
+	}
+
+	// Children without errors do not collide
+	e := NewErrorNode(nil)
+	e.DownField("labels").DownField("a")
+	e.DownField("labels").DownKey("a").Add(testValidateError("bad"))
+	actual, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("not expecting an error but got: %v", err)
+	}
+	if string(actual) != `{"labels":{"a":["bad"]}}` {
+		t.Errorf(`expected: {"labels":{"a":["bad"]}} but got: %s`, actual)
+	}
+}
@@ -0,0 +1,58 @@
+package tree
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+	"golang.org/x/text/message"
+)
+
+// Label is the human-readable name of the field this node holds the errors for.
+// Empty if no label was set
+func (n ErrorNode) Label() string {
+	return n.label
+}
+
+// SetLabel sets the human-readable name of the field this node holds the errors for.
+// The label is translated when the errors are localized, see issers.LabelMsgID
+func (n *ErrorNode) SetLabel(label string) {
+	n.label = label
+}
+
+// Localize renders e with the printer, using the label as the subject of the message
+// if a label is set and e implements ifaces.LabeledValidateError
+// @param label is the label of the node holding e, may be empty
+func Localize(e ifaces.ValidateError, p *message.Printer, label string) string {
+	if l, ok := e.(ifaces.LabeledValidateError); ok && len(label) != 0 {
+		return l.ErrorI18nLabeled(p, label)
+	}
+	return e.ErrorI18n(p)
+}
+
+// Messages localizes the errors for ONLY this node, using the node's label. See Localize
+func (n ErrorNode) Messages(p *message.Printer) []string {
+	return n.messages(p, SeverityError)
+}
+
+// WarningMessages localizes the warnings for ONLY this node, using the node's label. See Localize
+func (n ErrorNode) WarningMessages(p *message.Printer) []string {
+	return n.messages(p, SeverityWarning)
+}
+
+// messages localizes the errors or the warnings for ONLY this node
+func (n ErrorNode) messages(p *message.Printer, severity Severity) []string {
+	findings := n.findings(severity)
+	out := make([]string, 0, len(findings))
+	for _, e := range findings {
+		out = append(out, Localize(e, p, n.label))
+	}
+	return out
+}
+
+// LabelAt returns the label of the node at the path, relative to the receiver.
+// Empty if the node does not exist or has no label. Does not create nodes
+func (n *ErrorNode) LabelAt(path Path) string {
+	current := n.traverseTo(path)
+	if current == nil {
+		return ""
+	}
+	return current.label
+}
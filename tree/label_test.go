@@ -0,0 +1,87 @@
+package tree
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/wojnosystems/validates/ifaces"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+type testLabeledValidateError string
+
+func (v testLabeledValidateError) ErrorI18n(p *message.Printer) string {
+	return p.Sprint(string(v))
+}
+
+func (v testLabeledValidateError) ErrorI18nLabeled(p *message.Printer, label string) string {
+	return p.Sprintf("%s: %s", p.Sprintf(label), string(v))
+}
+
+func (v testLabeledValidateError) IsEqual(e ifaces.ValidateError) bool {
+	t, ok := e.(testLabeledValidateError)
+	return ok && t == v
+}
+
+func TestLocalize(t *testing.T) {
+	p := message.NewPrinter(language.AmericanEnglish)
+	cases := map[string]struct {
+		e        ifaces.ValidateError
+		label    string
+		expected string
+	}{
+		"labeled": {
+			e:        testLabeledValidateError("missing"),
+			label:    "Name",
+			expected: "Name: missing",
+		},
+		"no label": {
+			e:        testLabeledValidateError("missing"),
+			expected: "missing",
+		},
+		"not label-aware": {
+			e:        testValidateError("missing"),
+			label:    "Name",
+			expected: "missing",
+		},
+	}
+	for caseName, c := range cases {
+		if actual := Localize(c.e, p, c.label); actual != c.expected {
+			t.Errorf(`%s: expected "%s" but got "%s"`, caseName, c.expected, actual)
+		}
+	}
+}
+
+func TestErrorNode_MergeLabels(t *testing.T) {
+	src := NewErrorNode(nil)
+	src.DownField("name").SetLabel("Name")
+	src.DownField("name").Add(testLabeledValidateError("missing"))
+	src.DownField("age").SetLabel("Age")
+	src.DownField("age").Add(testLabeledValidateError("missing"))
+
+	dst := NewErrorNode(nil)
+	dst.DownField("name").SetLabel("Full name")
+	dst.Merge(src)
+
+	if label := dst.NamedChildren["name"].Label(); label != "Full name" {
+		t.Errorf(`expected the existing label to be kept but got "%s"`, label)
+	}
+	if label := dst.NamedChildren["age"].Label(); label != "Age" {
+		t.Errorf(`expected the label to be copied but got "%s"`, label)
+	}
+}
+
+func TestErrorNode_MarshalJSONLabeled(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("name").SetLabel("Name")
+	e.DownField("name").Add(testLabeledValidateError("missing"))
+
+	actual, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != `{"name":["Name: missing"]}` {
+		t.Errorf("expected the labeled message but got %s", actual)
+	}
+}
@@ -9,22 +9,172 @@ import (
 // PathSeparator is the symbol used to identify object paths
 var PathSeparator = "/"
 
+// segmentKind identifies what a Segment addresses
+type segmentKind int
+
+const (
+	// fieldSegment addresses a named field or struct
+	fieldSegment segmentKind = iota
+	// indexSegment addresses a position in an array
+	indexSegment
+	// keySegment addresses a value in a map
+	keySegment
+)
+
+// Segment is a single component of a Path: a field name, an index or a map key
+type Segment struct {
+	kind  segmentKind
+	name  string
+	index int
+}
+
+// IsIndex returns true if the segment addresses a position in an array, false if it addresses a field
+func (s Segment) IsIndex() bool {
+	return s.kind == indexSegment
+}
+
+// IsKey returns true if the segment addresses a value in a map
+func (s Segment) IsKey() bool {
+	return s.kind == keySegment
+}
+
+// FieldName returns the name of the field, or empty string if the segment is an index or a key
+func (s Segment) FieldName() string {
+	if s.kind != fieldSegment {
+		return ""
+	}
+	return s.name
+}
+
+// Key returns the map key, or empty string if the segment is a field or an index
+func (s Segment) Key() string {
+	if !s.IsKey() {
+		return ""
+	}
+	return s.name
+}
+
+// Index returns the position in the array, or -1 if the segment is a field or a key
+func (s Segment) Index() int {
+	if !s.IsIndex() {
+		return -1
+	}
+	return s.index
+}
+
 // Path is how we address items in the Validate.Is struct
-type Path string
+// Paths are immutable: navigating up or down returns a new Path and leaves the receiver untouched.
+// The textual form (e.g.: /bob/phones[0]/labels["app"]) is only built when String is called.
+// Map keys are written as Go-quoted strings inside brackets so that any key,
+// including ones holding the PathSeparator or brackets, can be told apart from fields and indexes
+// The zero value is the root path
+//
+// Path used to be a string. It's now a struct so that paths do not need to be
+// re-parsed as they are navigated, which breaks code that relied on the string:
+//   - tree.Path("/bob/phones[0]") no longer compiles, use ParsePath instead
+//   - == no longer compiles, use IsEqual instead
+//   - Path can no longer be used as a map key, use String as the key instead
+type Path struct {
+	// segments are the components of the path, starting from the root.
+	// This slice is shared between paths and must never be written to
+	segments []Segment
+}
 
 // NewPath creates a new path from root
 func NewPath() Path {
-	return Path(PathSeparator)
+	return Path{}
+}
+
+// ParsePath converts the textual form of a path, as returned by String, back into a Path,
+// e.g.: /bob/phones[0]/labels["app"]. This is also the form of the Path used to be a string,
+// so it converts paths that were stored or written out before Path became a struct
+// @return err if the path is malformed
+func ParsePath(s string) (p Path, err error) {
+	p = NewPath()
+	if !strings.HasPrefix(s, PathSeparator) {
+		return p, fmt.Errorf(`path must start with "%s": %s`, PathSeparator, s)
+	}
+	if s == PathSeparator {
+		return p, nil
+	}
+	rest := s
+	for len(rest) != 0 {
+		switch {
+		case strings.HasPrefix(rest, PathSeparator):
+			rest = rest[len(PathSeparator):]
+			end := strings.IndexAny(rest, "["+PathSeparator)
+			if end == -1 {
+				end = len(rest)
+			}
+			if strings.Contains(rest[:end], "]") {
+				return NewPath(), fmt.Errorf("path has an unexpected ']': %s", s)
+			}
+			p = p.DownField(rest[:end])
+			rest = rest[end:]
+		case strings.HasPrefix(rest, `["`):
+			quoted, qErr := strconv.QuotedPrefix(rest[1:])
+			if qErr != nil {
+				return NewPath(), fmt.Errorf("path has an invalid key: %s", s)
+			}
+			key, _ := strconv.Unquote(quoted)
+			rest = rest[1+len(quoted):]
+			if !strings.HasPrefix(rest, "]") {
+				return NewPath(), fmt.Errorf("path has an unterminated key: %s", s)
+			}
+			p = p.DownKey(key)
+			rest = rest[1:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return NewPath(), fmt.Errorf("path has an unterminated index: %s", s)
+			}
+			index, aErr := strconv.Atoi(rest[1:end])
+			if aErr != nil {
+				return NewPath(), fmt.Errorf("path has an invalid index: %s", s)
+			}
+			p = p.DownIndex(index)
+			rest = rest[end+1:]
+		default:
+			return NewPath(), fmt.Errorf("path has an unexpected character at: %s", rest)
+		}
+	}
+	return p, nil
 }
 
 // String returns the string representation of this path. This is really only useful for debugging
 func (p Path) String() string {
-	return string(p)
+	if p.IsRoot() {
+		return PathSeparator
+	}
+	var b strings.Builder
+	for _, s := range p.segments {
+		if s.IsIndex() {
+			b.WriteString("[")
+			b.WriteString(strconv.Itoa(s.index))
+			b.WriteString("]")
+		} else if s.IsKey() {
+			b.WriteString("[")
+			b.WriteString(strconv.Quote(s.name))
+			b.WriteString("]")
+		} else {
+			b.WriteString(PathSeparator)
+			b.WriteString(s.name)
+		}
+	}
+	return b.String()
 }
 
 // IsEqual returns true if the two paths point to the same location, false otherwise
 func (p Path) IsEqual(op Path) bool {
-	return string(p) == string(op)
+	if len(p.segments) != len(op.segments) {
+		return false
+	}
+	for i := range p.segments {
+		if p.segments[i] != op.segments[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // Up moves up the tree until reaching root, in which case, it returns the root
@@ -32,22 +182,7 @@ func (p Path) Up() Path {
 	if p.IsRoot() {
 		return p
 	}
-	if p.IsArrayElement() {
-		// Array elements need to go up to the array itself
-		index := strings.LastIndex(string(p), "[")
-		// index = -1 should be impossible as we cover this case in IsArrayElement
-		return Path(string(p)[0:index])
-	} else {
-		// Split the path:
-		parts := strings.Split(string(p), PathSeparator)
-		if p.IsAbsolute() {
-			// parts[0] will always be an empty string. We know we're not at the root path, so there should be at least 1 item
-			return Path(fmt.Sprintf("/%s", strings.Join(parts[1:len(parts)-1], PathSeparator)))
-		} else {
-			// no forward slash, if nothing left, should be empty string ""
-			return Path(strings.Join(parts[0:len(parts)-1], PathSeparator))
-		}
-	}
+	return Path{segments: p.segments[:len(p.segments)-1]}
 }
 
 // DownField goes down the path and references a specific field or a struct. Fields can be leaves or additional nodes
@@ -55,26 +190,38 @@ func (p Path) DownField(fieldName string) Path {
 	if !isValidFieldName(fieldName) {
 		panic(fmt.Errorf("invalid fieldName provided: %s", fieldName))
 	}
-	if p.IsAbsolute() && p.IsRoot() {
-		return Path(fmt.Sprintf("%s%s", string(p), fieldName))
-	}
-	return Path(fmt.Sprintf("%s%s%s", string(p), PathSeparator, fieldName))
+	return p.down(Segment{kind: fieldSegment, name: fieldName})
 }
 
 // DownIndex goes down the path assuming that the current element is an array
 func (p Path) DownIndex(index int) Path {
-	return Path(fmt.Sprintf("%s[%d]", string(p), index))
+	return p.down(Segment{kind: indexSegment, index: index})
+}
+
+// DownKey goes down the path assuming that the current element is a map. Unlike field names, any string is a valid key
+func (p Path) DownKey(key string) Path {
+	return p.down(Segment{kind: keySegment, name: key})
+}
+
+// down returns a new path with the segment appended. The segments are always copied so that
+// sibling paths created from the same parent never share the appended segment
+func (p Path) down(s Segment) Path {
+	segments := make([]Segment, len(p.segments)+1)
+	copy(segments, p.segments)
+	segments[len(p.segments)] = s
+	return Path{segments: segments}
 }
 
 // IsRoot returns true if the path is at the root, false if not. The root is defined as being equal to "NewPath", the path references no fields or child objects
 // A root Path has no parent
 func (p Path) IsRoot() bool {
-	return string(p) == PathSeparator
+	return len(p.segments) == 0
 }
 
 // IsAbsolute is true if the path is absolute (starts with the /)
+// All paths are built from the root, so this is always true
 func (p Path) IsAbsolute() bool {
-	return strings.HasPrefix(string(p), PathSeparator)
+	return true
 }
 
 // IsArrayElement returns true if the item currently referenced is in an array
@@ -82,10 +229,7 @@ func (p Path) IsArrayElement() bool {
 	if p.IsRoot() {
 		return false
 	}
-	// get last element
-	parts := strings.Split(string(p), PathSeparator)
-	lastPart := parts[len(parts)-1]
-	return strings.Index(lastPart, "[") != -1
+	return p.segments[len(p.segments)-1].IsIndex()
 }
 
 // Index returns the index of the current path, or -1 if invalid
@@ -93,25 +237,42 @@ func (p Path) Index() int {
 	if !p.IsArrayElement() {
 		return -1
 	}
-	// get last element
-	parts := strings.Split(string(p), PathSeparator)
-	lastPart := parts[len(parts)-1]
-	startOfIndex := strings.LastIndex(lastPart, "[") + 2
-	endOfIndex := strings.LastIndex(lastPart, "]") + 1
-	indexStr := string(p)[startOfIndex:endOfIndex]
-	// Error should be impossible, we're setting indexes
-	dex, _ := strconv.Atoi(indexStr)
-	return dex
+	return p.segments[len(p.segments)-1].index
+}
+
+// IsMapValue returns true if the item currently referenced is in a map
+func (p Path) IsMapValue() bool {
+	if p.IsRoot() {
+		return false
+	}
+	return p.segments[len(p.segments)-1].IsKey()
 }
 
-// FieldName returns the name of the current field, or empty string if not valid (e.g.: we're at the root or in an index)
+// Key returns the map key of the current path, or empty string if not valid (e.g.: we're at the root, in a field or in an index)
+func (p Path) Key() string {
+	if p.IsRoot() {
+		return ""
+	}
+	return p.segments[len(p.segments)-1].Key()
+}
+
+// FieldName returns the name of the current field, or empty string if not valid (e.g.: we're at the root, in an index or in a map)
 func (p Path) FieldName() string {
-	if p.IsRoot() || p.IsArrayElement() {
+	if p.IsRoot() {
 		return ""
 	}
-	// get last element
-	parts := strings.Split(string(p), PathSeparator)
-	return parts[len(parts)-1]
+	return p.segments[len(p.segments)-1].FieldName()
+}
+
+// Len returns the number of segments in the path. The root has no segments
+func (p Path) Len() int {
+	return len(p.segments)
+}
+
+// Segment returns the segment at position i, where 0 is the segment closest to the root
+// Panics if i is out of range, like a slice index would
+func (p Path) Segment(i int) Segment {
+	return p.segments[i]
 }
 
 // Depth returns how nested this element is. if IsRoot is true, Depth returns 0. A field at Depth 0 is also zero.
@@ -124,11 +285,7 @@ func (p Path) Depth() int {
 	if p.IsRoot() {
 		return 0
 	}
-	countRoot := 0
-	if p.IsAbsolute() {
-		countRoot++
-	}
-	return strings.Count(string(p), PathSeparator) - countRoot + strings.Count(string(p), "[")
+	return len(p.segments) - 1
 }
 
 // isValidFieldName returns true if the field name provided is valid, false if not
@@ -138,28 +295,30 @@ func isValidFieldName(fieldName string) bool {
 }
 
 // EachComponent iterates through each component and calls the fieldName function if it's a named field component and calls the index function if it's a position in an index
+// Map keys are passed to the fieldName function. Use EachSegment to tell keys and fields apart
 // @return true if this ran to completion, false if methods triggered an early return
 func (p Path) EachComponent(fieldName func(fieldName string) bool, index func(index int) bool) bool {
-	parts := make([]Path, 0, 2)
-	{
-		part := p
-		for !part.IsRoot() && len(string(part)) != 0 {
-			parts = append(parts, part)
-			part = part.Up()
-		}
-	}
-
-	// need to traverse in reverse order
-	for i := len(parts) - 1; i >= 0; i-- {
-		if parts[i].IsArrayElement() {
-			if !index(parts[i].Index()) {
+	for _, s := range p.segments {
+		if s.IsIndex() {
+			if !index(s.index) {
 				return false
 			}
 		} else {
-			if !fieldName(parts[i].FieldName()) {
+			if !fieldName(s.name) {
 				return false
 			}
 		}
 	}
 	return true
 }
+
+// EachSegment iterates through each segment, starting from the root
+// @return true if this ran to completion, false if segment triggered an early return
+func (p Path) EachSegment(segment func(s Segment) bool) bool {
+	for _, s := range p.segments {
+		if !segment(s) {
+			return false
+		}
+	}
+	return true
+}
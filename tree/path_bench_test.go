@@ -0,0 +1,56 @@
+package tree
+
+import (
+	"strconv"
+	"testing"
+)
+
+const benchmarkDepth = 10
+
+// deepPath returns a path benchmarkDepth fields deep, ending in an index
+func deepPath() Path {
+	p := NewPath()
+	for i := 0; i < benchmarkDepth; i++ {
+		p = p.DownField("level" + strconv.Itoa(i))
+	}
+	return p.DownIndex(benchmarkDepth)
+}
+
+func BenchmarkPath_DownField(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		deepPath()
+	}
+}
+
+func BenchmarkPath_Up(b *testing.B) {
+	p := deepPath()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for q := p; !q.IsRoot(); q = q.Up() {
+		}
+	}
+}
+
+func BenchmarkPath_EachComponent(b *testing.B) {
+	p := deepPath()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		p.EachComponent(func(fieldName string) bool {
+			return true
+		}, func(index int) bool {
+			return true
+		})
+	}
+}
+
+func BenchmarkPath_String(b *testing.B) {
+	p := deepPath()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = p.String()
+	}
+}
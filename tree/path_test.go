@@ -163,3 +163,79 @@ func isStringArrayEqual(a, b []string) bool {
 	}
 	return true
 }
+
+func TestPath_DownIsolatesSiblings(t *testing.T) {
+	parent := NewPath().DownField("puppy")
+	a := parent.Up().DownField("zoey")
+	b := parent.Up().DownField("slater")
+	if a.String() != "/zoey" || b.String() != "/slater" {
+		t.Errorf(`expected "/zoey" and "/slater" but got: "%s" and "%s"`, a, b)
+	}
+	if parent.String() != "/puppy" {
+		t.Errorf(`expected parent to remain "/puppy" but got: "%s"`, parent)
+	}
+}
+
+func TestPath_Segment(t *testing.T) {
+	var zero Path
+	if !zero.IsRoot() || zero.String() != "/" {
+		t.Errorf(`expected the zero value to be the root, but got: "%s"`, zero)
+	}
+
+	p := NewPath().DownField("puppy").DownIndex(3)
+	if p.Len() != 2 {
+		t.Fatalf("expected 2 segments but got: %d", p.Len())
+	}
+	if p.Segment(0).IsIndex() || p.Segment(0).FieldName() != "puppy" || p.Segment(0).Index() != -1 {
+		t.Errorf("expected first segment to be the field puppy but got: %v", p.Segment(0))
+	}
+	if !p.Segment(1).IsIndex() || p.Segment(1).Index() != 3 {
+		t.Errorf("expected second segment to be the index 3 but got: %v", p.Segment(1))
+	}
+}
+
+func TestPath_DownKey(t *testing.T) {
+	p := NewPath().DownField("labels").DownKey(`a/"b"`)
+	expected := `/labels["a/\"b\""]`
+	if p.String() != expected {
+		t.Errorf(`expected path to be: %s, but got %s`, expected, p.String())
+	}
+	if !p.IsMapValue() || p.Key() != `a/"b"` || p.FieldName() != "" || p.Index() != -1 {
+		t.Errorf(`expected "%s" to reference the map key`, p.String())
+	}
+	if !p.Up().IsEqual(NewPath().DownField("labels")) {
+		t.Errorf(`expected up to be /labels, but got: %s`, p.Up())
+	}
+	if p.IsEqual(NewPath().DownField("labels").DownField("a")) {
+		t.Error("expected keys and fields to be different")
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	cases := map[string]Path{
+		"/":                       NewPath(),
+		"/bob":                    NewPath().DownField("bob"),
+		"/bob/phones[0]":          NewPath().DownField("bob").DownField("phones").DownIndex(0),
+		"/bob/phones[0][-1]/kind": NewPath().DownField("bob").DownField("phones").DownIndex(0).DownIndex(-1).DownField("kind"),
+		`/labels["a/b"]["x[1]"]`:  NewPath().DownField("labels").DownKey("a/b").DownKey("x[1]"),
+	}
+	for s, expected := range cases {
+		actual, err := ParsePath(s)
+		if err != nil {
+			t.Errorf("%s: not expecting an error but got: %v", s, err)
+			continue
+		}
+		if !expected.IsEqual(actual) {
+			t.Errorf("%s: expected %s but got %s", s, expected, actual)
+		}
+		if actual.String() != s {
+			t.Errorf("%s: expected String to round-trip but got %s", s, actual)
+		}
+	}
+
+	for _, s := range []string{"", "bob", "/bob]", "/bob[x]", "/bob[0", `/bob["a]`, `/bob["a"`} {
+		if _, err := ParsePath(s); err == nil {
+			t.Errorf("%s: expected an error", s)
+		}
+	}
+}
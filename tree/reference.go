@@ -0,0 +1,10 @@
+package tree
+
+// Referencer is implemented by ValidateErrors that are about the relation
+// between the field they are recorded at and other fields, e.g.:
+// "should match password" recorded at /password_confirmation.
+// Renderers use it to link the fields. Implementing it is optional
+type Referencer interface {
+	// References returns the paths to the other fields, from the root
+	References() []Path
+}
@@ -0,0 +1,89 @@
+package tree
+
+import (
+	"github.com/wojnosystems/validates/ifaces"
+)
+
+// Severity tells errors, which make the input invalid, apart from
+// warnings, which are advisory and do not make the input invalid
+type Severity int
+
+const (
+	// SeverityError is the severity of the errors added with Add
+	SeverityError Severity = iota
+
+	// SeverityWarning is the severity of the warnings added with AddWarning
+	SeverityWarning
+)
+
+// String returns "error" or "warning"
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// AddWarning appends the warning to this node. Warnings do not make HasErrors true
+func (n *ErrorNode) AddWarning(e ifaces.ValidateError) {
+	n.warnings = append(n.warnings, e)
+}
+
+// Warnings returns the list of warnings for ONLY this node. Does not recurse to children
+func (n ErrorNode) Warnings() []ifaces.ValidateError {
+	return n.warnings
+}
+
+// HasWarnings is true if there is at least 1 warning in itself OR its children
+func (n ErrorNode) HasWarnings() bool {
+	return n.has(SeverityWarning)
+}
+
+// WarningsLen is the number of warnings in itself AND its children
+func (n ErrorNode) WarningsLen() int {
+	count := len(n.warnings)
+	for _, c := range n.NamedChildren {
+		count += c.WarningsLen()
+	}
+	for _, c := range n.NumberedChildren {
+		count += c.WarningsLen()
+	}
+	for _, c := range n.KeyedChildren {
+		count += c.WarningsLen()
+	}
+	return count
+}
+
+// findings returns the errors or the warnings for ONLY this node
+func (n ErrorNode) findings(severity Severity) []ifaces.ValidateError {
+	if severity == SeverityWarning {
+		return n.warnings
+	}
+	return n.errs
+}
+
+// has is HasErrors or HasWarnings, depending on the severity
+func (n ErrorNode) has(severity Severity) bool {
+	if severity == SeverityError {
+		return n.HasErrors()
+	}
+	if len(n.warnings) != 0 {
+		return true
+	}
+	for _, c := range n.NamedChildren {
+		if c.has(severity) {
+			return true
+		}
+	}
+	for _, c := range n.NumberedChildren {
+		if c.has(severity) {
+			return true
+		}
+	}
+	for _, c := range n.KeyedChildren {
+		if c.has(severity) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package tree
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestErrorNode_Warnings(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("password").AddWarning(testValidateError("weak"))
+	e.DownField("emails").DownIndex(1).AddWarning(testValidateError("unverified"))
+	e.DownField("name").Add(testValidateError("missing"))
+
+	if !e.HasWarnings() || e.WarningsLen() != 2 {
+		t.Errorf("expected 2 warnings but got %d", e.WarningsLen())
+	}
+	if e.DownField("password").HasErrors() {
+		t.Error("expected warnings to not be errors")
+	}
+	if e.DownField("name").HasWarnings() {
+		t.Error("expected errors to not be warnings")
+	}
+
+	expected := map[string][]string{
+		"emails[1]": {"unverified"},
+		"password":  {"weak"},
+	}
+	if actual := e.FlattenWarnings(nil, PathStyleDotted); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+	if actual := e.Flatten(nil, PathStyleDotted); len(actual) != 1 {
+		t.Errorf("expected only the errors but got %v", actual)
+	}
+}
+
+func TestErrorNode_MarshalJSONSeverity(t *testing.T) {
+	e := NewErrorNode(nil)
+	e.DownField("password").AddWarning(testValidateError("weak"))
+	e.DownField("emails").DownIndex(1).AddWarning(testValidateError("unverified"))
+	e.DownField("name").Add(testValidateError("missing"))
+
+	cases := map[Severity]string{
+		SeverityError:   `{"name":["missing"]}`,
+		SeverityWarning: `{"emails":[null,["unverified"]],"password":["weak"]}`,
+	}
+	for severity, expected := range cases {
+		actual, err := json.Marshal(e.JSON(JSONOptions{Severity: severity}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != expected {
+			t.Errorf("%s: expected %s but got %s", severity, expected, actual)
+		}
+	}
+}
+
+func TestErrorNode_MergeWarnings(t *testing.T) {
+	src := NewErrorNode(nil)
+	src.DownField("password").AddWarning(testValidateError("weak"))
+
+	dst := NewErrorNode(nil)
+	dst.DownField("password").AddWarning(testValidateError("weak"))
+	src.DownField("name").AddWarning(testValidateError("deprecated"))
+	added, warningsAdded := dst.Merge(src)
+	if added != 0 {
+		t.Errorf("expected warnings to not be counted as errors but got %d", added)
+	}
+	if warningsAdded != 1 {
+		t.Errorf("expected 1 warning to be added but got %d", warningsAdded)
+	}
+	if dst.WarningsLen() != 2 {
+		t.Errorf("expected the duplicate warning to be skipped but got %d", dst.WarningsLen())
+	}
+	if dst.IsEqual(NewErrorNode(nil)) {
+		t.Error("expected IsEqual to compare warnings")
+	}
+}
@@ -0,0 +1,129 @@
+package tree
+
+import (
+	"errors"
+	"iter"
+	"sort"
+
+	"github.com/wojnosystems/validates/ifaces"
+)
+
+// errStopWalk is used internally to end a Walk early without reporting an error
+var errStopWalk = errors.New("stop walk")
+
+// Walk calls visit for every node, starting with the receiver, that has at least 1 error.
+// Nodes are visited depth-first in a deterministic order: a node's own errors come first,
+// then its named children sorted lexically, then its keyed children sorted lexically,
+// then its numbered children in ascending order.
+// Paths are relative to the receiver, which is visited at NewPath()
+// @param visit is called with the path to the node and the errors for ONLY that node.
+//   The errs slice must not be modified. If visit returns an error, the walk stops
+// @return err the error returned by visit, if any
+//
+// @example
+// ```go
+// err := is.Errors().Walk(func(path tree.Path, errs []ifaces.ValidateError) error {
+//   for _, e := range errs {
+//     fmt.Println(path, e.ErrorI18n(p))
+//   }
+//   return nil
+// })
+// ```
+func (n *ErrorNode) Walk(visit func(path Path, errs []ifaces.ValidateError) error) error {
+	return n.WalkNodes(func(path Path, node *ErrorNode) error {
+		return visit(path, node.errs)
+	})
+}
+
+// WalkNodes is Walk, but visit is called with the node itself so that
+// its Label can be used, e.g.: with Messages
+//
+// @example
+// ```go
+// err := is.Errors().WalkNodes(func(path tree.Path, node *tree.ErrorNode) error {
+//   fmt.Println(path, node.Messages(p))
+//   return nil
+// })
+// ```
+func (n *ErrorNode) WalkNodes(visit func(path Path, node *ErrorNode) error) error {
+	return n.walk(NewPath(), SeverityError, visit)
+}
+
+// WalkWarnings is Walk, but for the warnings: visit is called for every node
+// that has at least 1 warning, with the warnings for ONLY that node
+func (n *ErrorNode) WalkWarnings(visit func(path Path, warnings []ifaces.ValidateError) error) error {
+	return n.walk(NewPath(), SeverityWarning, func(path Path, node *ErrorNode) error {
+		return visit(path, node.warnings)
+	})
+}
+
+// walk is the recursive implementation of WalkNodes and WalkWarnings, path is the path to n.
+// Only nodes with findings of the severity are visited
+func (n *ErrorNode) walk(path Path, severity Severity, visit func(path Path, node *ErrorNode) error) error {
+	if len(n.findings(severity)) != 0 {
+		if err := visit(path, n); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(n.NamedChildren) {
+		if err := n.NamedChildren[name].walk(path.DownField(name), severity, visit); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range sortedKeys(n.KeyedChildren) {
+		if err := n.KeyedChildren[key].walk(path.DownKey(key), severity, visit); err != nil {
+			return err
+		}
+	}
+
+	for _, index := range sortedIndexes(n.NumberedChildren) {
+		if err := n.NumberedChildren[index].walk(path.DownIndex(index), severity, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns an iterator over every error in the tree, paired with the path to the node
+// holding it. Errors are produced in the same order as Walk visits them
+//
+// @example
+// ```go
+// for path, e := range is.Errors().All() {
+//   fmt.Println(path, e.ErrorI18n(p))
+// }
+// ```
+func (n *ErrorNode) All() iter.Seq2[Path, ifaces.ValidateError] {
+	return func(yield func(Path, ifaces.ValidateError) bool) {
+		_ = n.Walk(func(path Path, errs []ifaces.ValidateError) error {
+			for _, e := range errs {
+				if !yield(path, e) {
+					return errStopWalk
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// sortedKeys returns the keys of the children sorted lexically
+func sortedKeys(children map[string]*ErrorNode) []string {
+	keys := make([]string, 0, len(children))
+	for key := range children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedIndexes returns the indexes of the children in ascending order
+func sortedIndexes(children map[int]*ErrorNode) []int {
+	indexes := make([]int, 0, len(children))
+	for index := range children {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
@@ -0,0 +1,79 @@
+package tree
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wojnosystems/validates/ifaces"
+)
+
+func newTestWalkTree() *ErrorNode {
+	e := NewErrorNode(nil)
+	e.Add(testValidateError("root"))
+	e.DownField("zoey").Add(testValidateError("z"))
+	emails := e.DownField("emails")
+	emails.DownIndex(10).Add(testValidateError("ten"))
+	emails.DownIndex(2).Add(testValidateError("two"))
+	emails.Add(testValidateError("few"))
+	e.DownField("labels").DownKey("b").Add(testValidateError("b"))
+	e.DownField("labels").DownKey("a").Add(testValidateError("a"))
+	e.DownField("empty").DownField("nothing")
+	return e
+}
+
+func TestErrorNode_Walk(t *testing.T) {
+	expected := []string{
+		`/ root`,
+		`/emails few`,
+		`/emails[2] two`,
+		`/emails[10] ten`,
+		`/labels["a"] a`,
+		`/labels["b"] b`,
+		`/zoey z`,
+	}
+	actual := make([]string, 0)
+	err := newTestWalkTree().Walk(func(path Path, errs []ifaces.ValidateError) error {
+		for _, e := range errs {
+			actual = append(actual, path.String()+" "+string(e.(testValidateError)))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error("not expecting an error")
+	}
+	if !isStringArrayEqual(actual, expected) {
+		t.Errorf("expected to visit: %v, but got: %v", expected, actual)
+	}
+}
+
+func TestErrorNode_WalkStops(t *testing.T) {
+	stop := errors.New("stop")
+	visited := 0
+	err := newTestWalkTree().Walk(func(path Path, errs []ifaces.ValidateError) error {
+		visited++
+		if path.FieldName() == "emails" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("expected the stop error but got: %v", err)
+	}
+	if visited != 2 {
+		t.Errorf("expected to visit 2 nodes but visited %d", visited)
+	}
+}
+
+func TestErrorNode_All(t *testing.T) {
+	actual := make([]string, 0)
+	for path, e := range newTestWalkTree().All() {
+		actual = append(actual, path.String()+" "+string(e.(testValidateError)))
+		if len(actual) == 3 {
+			break
+		}
+	}
+	expected := []string{`/ root`, `/emails few`, `/emails[2] two`}
+	if !isStringArrayEqual(actual, expected) {
+		t.Errorf("expected: %v, but got: %v", expected, actual)
+	}
+}
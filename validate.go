@@ -1,6 +1,8 @@
 package validates
 
 import (
+	"context"
+
 	"github.com/wojnosystems/validates/issers"
 )
 
@@ -41,6 +43,29 @@ func On(on issers.Validater) (i *issers.Is, err error) {
 	return on.Validate(issers.NewRoot())
 }
 
+// OnContext performs the validation on a root struct within ctx. If on
+// implements issers.ValidaterContext, ValidateContext is used instead of
+// Validate. ctx is available to every validation through Is.Context
+//
+// @param ctx once cancelled, traversal of the struct stops
+// @param on the struct to start performing validations on
+// @return i the validation result containing all of the validation errors
+//   recorded before the context was cancelled
+// @return err any errors encountered while processing the validations,
+//   or ctx.Err() if the context was cancelled before validation completed
+func OnContext(ctx context.Context, on issers.Validater) (i *issers.Is, err error) {
+	i = issers.NewRoot(issers.WithContext(ctx))
+	if vc, ok := on.(issers.ValidaterContext); ok {
+		_, err = vc.ValidateContext(ctx, i)
+	} else {
+		_, err = on.Validate(i)
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	return i, err
+}
+
 // NotEmptyString returns true if the string is not empty
 func NotEmptyString(s string) bool {
 	return len(s) != 0
@@ -1,6 +1,11 @@
 package validates
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/wojnosystems/validates/issers"
+)
 
 func TestNotEmptyString(t *testing.T) {
 	cases := []struct {
@@ -29,3 +34,66 @@ func TestNotEmptyString(t *testing.T) {
 		}
 	}
 }
+
+type testContextItem struct {
+	Name   string
+	cancel context.CancelFunc
+}
+
+func (r testContextItem) Validate(is *issers.Is) (*issers.Is, error) {
+	return r.ValidateContext(is.Context(), is)
+}
+
+func (r testContextItem) ValidateContext(ctx context.Context, is *issers.Is) (*issers.Is, error) {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	is.WithField("name", func(is *issers.Is) {
+		is.StringNotEmpty(r.Name, nil)
+	})
+	return is, nil
+}
+
+type testContextRoot struct {
+	Items []issers.Validater
+}
+
+func (r testContextRoot) Validate(is *issers.Is) (*issers.Is, error) {
+	return is, is.ValidEachStruct("items", r.Items)
+}
+
+func TestOnContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	root := testContextRoot{
+		Items: []issers.Validater{
+			&testContextItem{},
+			&testContextItem{cancel: cancel},
+			&testContextItem{},
+		},
+	}
+	is, err := OnContext(ctx, root)
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled but got: %v", err)
+	}
+	if is.Len() != 1 {
+		t.Errorf("expected only the error recorded before cancellation, but got %d errors", is.Len())
+	}
+}
+
+func TestOnContext_NotCancelled(t *testing.T) {
+	root := testContextRoot{
+		Items: []issers.Validater{
+			&testContextItem{},
+			&testContextItem{Name: "chris"},
+		},
+	}
+	is, err := OnContext(context.Background(), root)
+	if err != nil {
+		t.Errorf("not expecting an error, but got: %v", err)
+	}
+	if is.Len() != 1 {
+		t.Errorf("expected 1 error but got %d", is.Len())
+	}
+}